@@ -0,0 +1,48 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// VerifyAuth checks the configured credentials with GET /myself. If they are
+// rejected, the token is tried with the other of basic and bearer auth, so that
+// a wrong auth_type is told apart from a wrong token.
+func VerifyAuth(debug bool) (*jira.Me, error) {
+	me, err := DefaultClient(debug).Me()
+	if err == nil {
+		return me, nil
+	}
+
+	var resp *jira.ErrUnexpectedResponse
+	if !errors.As(err, &resp) || resp.StatusCode != http.StatusUnauthorized {
+		return nil, err
+	}
+	alt, ok := alternativeAuthType(resp.AuthType)
+	if !ok {
+		return nil, err
+	}
+
+	config := resolveConfig(jira.Config{Debug: debug, AuthType: &alt})
+	if _, altErr := newClient(config).Me(); altErr != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf(
+		"the credentials are rejected with %s auth but accepted with %s auth, set 'auth_type: %s' in the config",
+		resp.AuthType, alt, alt,
+	)
+}
+
+// alternativeAuthType returns the auth type a token rejected with at may be meant for.
+func alternativeAuthType(at jira.AuthType) (jira.AuthType, bool) {
+	switch at {
+	case jira.AuthTypeBasic:
+		return jira.AuthTypeBearer, true
+	case jira.AuthTypeBearer:
+		return jira.AuthTypeBasic, true
+	}
+	return "", false
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+func TestVerifyAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"accountId": "a12b3", "displayName": "Person A"}`))
+	}))
+	defer server.Close()
+
+	t.Cleanup(func() {
+		viper.Reset()
+		jiraClient = nil
+	})
+	viper.Set("server", server.URL)
+	viper.Set("login", "person@example.com")
+	viper.Set("api_token", "secret")
+
+	viper.Set("auth_type", "bearer")
+	me, err := VerifyAuth(false)
+	assert.NoError(t, err)
+	assert.Equal(t, "Person A", me.Name)
+
+	jiraClient = nil
+	viper.Set("auth_type", "basic")
+	_, err = VerifyAuth(false)
+	assert.EqualError(t, err, "the credentials are rejected with basic auth but accepted with bearer auth, set 'auth_type: bearer' in the config")
+
+	jiraClient = nil
+	viper.Set("api_token", "wrong")
+	_, err = VerifyAuth(false)
+	var resp *jira.ErrUnexpectedResponse
+	assert.ErrorAs(t, err, &resp)
+	assert.Equal(t, jira.AuthTypeBasic, resp.AuthType)
+	assert.Contains(t, err.Error(), "Basic auth failed (401 Unauthorized)")
+}
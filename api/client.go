@@ -1,6 +1,7 @@
 package api
 
 import (
+	"sync"
 	"time"
 
 	"github.com/spf13/viper"
@@ -13,14 +14,32 @@ import (
 
 const clientTimeout = 15 * time.Second
 
-var jiraClient *jira.Client
+// KeyringService is the service name the API token is stored under in the system keyring.
+const KeyringService = "jira-cli"
+
+var (
+	jiraClient *jira.Client
+	// clientMu guards jiraClient, the UI fetches issues from several goroutines.
+	clientMu sync.Mutex
+)
 
 // Client initializes and returns jira client.
 func Client(config jira.Config) *jira.Client {
+	clientMu.Lock()
+	defer clientMu.Unlock()
+
 	if jiraClient != nil {
 		return jiraClient
 	}
 
+	jiraClient = newClient(resolveConfig(config))
+
+	return jiraClient
+}
+
+// resolveConfig fills what isn't set in the config from the configuration
+// file, the token is looked up in the environment, .netrc and the keyring.
+func resolveConfig(config jira.Config) jira.Config {
 	if config.Server == "" {
 		config.Server = viper.GetString("server")
 	}
@@ -37,7 +56,7 @@ func Client(config jira.Config) *jira.Client {
 		}
 	}
 	if config.APIToken == "" {
-		secret, _ := keyring.Get("jira-cli", config.Login)
+		secret, _ := keyring.Get(KeyringService, config.Login)
 		config.APIToken = secret
 	}
 	if config.AuthType == nil {
@@ -61,13 +80,15 @@ func Client(config jira.Config) *jira.Client {
 		config.MTLSConfig.ClientKey = viper.GetString("mtls.client_key")
 	}
 
-	jiraClient = jira.NewClient(
+	return config
+}
+
+func newClient(config jira.Config) *jira.Client {
+	return jira.NewClient(
 		config,
 		jira.WithTimeout(clientTimeout),
 		jira.WithInsecureTLS(*config.Insecure),
 	)
-
-	return jiraClient
 }
 
 // DefaultClient returns default jira client.
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// epicProgressConcurrency caps the number of count queries in flight.
+const epicProgressConcurrency = 5
+
+// EpicProgress holds the number of done and all child issues of an epic.
+type EpicProgress struct {
+	Done  int
+	Total int
+}
+
+// Percent returns the share of done child issues, 0 for an epic without children.
+func (p EpicProgress) Percent() int {
+	if p.Total == 0 {
+		return 0
+	}
+	return p.Done * 100 / p.Total
+}
+
+// EpicChildrenJQL returns a JQL clause matching child issues of the epic.
+// Next-gen projects link children via parent, classic ones via the Epic Link field.
+func EpicChildrenJQL(key, projectType string) string {
+	if projectType == jira.ProjectTypeNextGen {
+		return fmt.Sprintf("parent = %q", key)
+	}
+	return fmt.Sprintf(`"Epic Link" = %q`, key)
+}
+
+// ProxyEpicsProgress counts done and all child issues of the given epics.
+// Only counts are requested, no issues are fetched. Epics that fail to load are skipped.
+func ProxyEpicsProgress(c *jira.Client, projectType string, keys []string) map[string]EpicProgress {
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, epicProgressConcurrency)
+		out = make(map[string]EpicProgress, len(keys))
+	)
+
+	count := func(jql string) (int, error) {
+		sem <- struct{}{}
+		defer func() { <-sem }()
+
+		resp, err := ProxySearch(c, jql, 0, 0)
+		if err != nil {
+			return 0, err
+		}
+		return resp.Total, nil
+	}
+
+	for _, key := range keys {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+
+			children := EpicChildrenJQL(key, projectType)
+
+			total, err := count(children)
+			if err != nil {
+				return
+			}
+			done, err := count(children + " AND statusCategory = Done")
+			if err != nil {
+				return
+			}
+
+			mu.Lock()
+			out[key] = EpicProgress{Done: done, Total: total}
+			mu.Unlock()
+		}(key)
+	}
+	wg.Wait()
+
+	return out
+}
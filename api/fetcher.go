@@ -0,0 +1,181 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+	"github.com/jorres/jira-tui/pkg/jira/filter"
+)
+
+// Fetcher abstracts the read calls the UI makes to populate its views,
+// so that a live Jira instance can be swapped for local fixtures.
+type Fetcher interface {
+	Search(jql string, from, limit uint) (*jira.SearchResult, error)
+	GetIssue(key string, opts ...filter.Filter) (*jira.Issue, error)
+}
+
+var fetcher Fetcher
+
+const (
+	// SearchPageSize is the most issues Jira returns for a single search call.
+	SearchPageSize = 100
+	// SearchAllCap is the most issues fetched when paging through every result,
+	// so that a broad query doesn't walk through the whole instance.
+	SearchAllCap = 5000
+)
+
+// SearchAll pages through the results of the query, starting at from, until
+// limit issues or all the matching ones are fetched. The total of the returned
+// result is the number of issues matching the query.
+func SearchAll(f Fetcher, jql string, from, limit uint) (*jira.SearchResult, error) {
+	if limit <= SearchPageSize {
+		return f.Search(jql, from, limit)
+	}
+
+	out := &jira.SearchResult{StartAt: int(from), MaxResults: int(limit)}
+	for fetched := uint(0); fetched < limit; {
+		resp, err := f.Search(jql, from+fetched, min(SearchPageSize, limit-fetched))
+		if err != nil {
+			return nil, err
+		}
+		out.Total = resp.Total
+		out.Issues = append(out.Issues, resp.Issues...)
+
+		fetched += uint(len(resp.Issues))
+		if len(resp.Issues) == 0 || int(from+fetched) >= resp.Total {
+			break
+		}
+	}
+
+	return out, nil
+}
+
+// UseFixtures makes DefaultFetcher serve data from JSON files in dir instead of Jira.
+func UseFixtures(dir string) error {
+	st, err := os.Stat(dir)
+	if err != nil {
+		return err
+	}
+	if !st.IsDir() {
+		return fmt.Errorf("fixtures path %q is not a directory", dir)
+	}
+	fetcher = &FixtureFetcher{Dir: dir}
+	return nil
+}
+
+// IsUsingFixtures tells if the data is served from local fixtures.
+func IsUsingFixtures() bool {
+	_, ok := fetcher.(*FixtureFetcher)
+	return ok
+}
+
+// DefaultFetcher returns the configured fetcher, defaulting to the live jira client.
+func DefaultFetcher(debug bool) Fetcher {
+	if fetcher != nil {
+		return fetcher
+	}
+	return &LiveFetcher{Client: DefaultClient(debug)}
+}
+
+// LiveFetcher fetches data from a Jira instance based on configured installation type.
+type LiveFetcher struct {
+	Client *jira.Client
+}
+
+// Search implements Fetcher.
+func (f *LiveFetcher) Search(jql string, from, limit uint) (*jira.SearchResult, error) {
+	return ProxySearch(f.Client, jql, from, limit)
+}
+
+// GetIssue implements Fetcher.
+func (f *LiveFetcher) GetIssue(key string, opts ...filter.Filter) (*jira.Issue, error) {
+	return ProxyGetIssue(f.Client, key, opts...)
+}
+
+// FixtureFetcher serves data from JSON files shaped like Jira API responses:
+//
+//	<dir>/search.json       response of GET /search, used for every query
+//	<dir>/issue/<KEY>.json  response of GET /issue/<KEY>, optional
+//
+// If an issue file is missing, the issue is looked up in search.json.
+type FixtureFetcher struct {
+	Dir string
+}
+
+// Search implements Fetcher. The JQL is ignored, all fixture issues are returned.
+func (f *FixtureFetcher) Search(_ string, from, limit uint) (*jira.SearchResult, error) {
+	raw, err := f.searchIssues()
+	if err != nil {
+		return nil, err
+	}
+
+	out := jira.SearchResult{StartAt: int(from), MaxResults: int(limit), Total: len(raw)}
+	for i := int(from); i < len(raw) && (limit == 0 || i < int(from+limit)); i++ {
+		iss, err := f.decodeIssue(raw[i])
+		if err != nil {
+			return nil, err
+		}
+		out.Issues = append(out.Issues, iss)
+	}
+
+	return &out, nil
+}
+
+// GetIssue implements Fetcher.
+func (f *FixtureFetcher) GetIssue(key string, opts ...filter.Filter) (*jira.Issue, error) {
+	b, err := os.ReadFile(filepath.Join(f.Dir, "issue", key+".json"))
+	if err == nil {
+		return f.decodeIssue(b, opts...)
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	raw, err := f.searchIssues()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range raw {
+		var head struct {
+			Key string `json:"key"`
+		}
+		if err := json.Unmarshal(r, &head); err == nil && head.Key == key {
+			return f.decodeIssue(r, opts...)
+		}
+	}
+
+	return nil, fmt.Errorf("issue %s not found in fixtures at %s", key, f.Dir)
+}
+
+func (f *FixtureFetcher) searchIssues() ([]json.RawMessage, error) {
+	b, err := os.ReadFile(filepath.Join(f.Dir, "search.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var out struct {
+		Issues []json.RawMessage `json:"issues"`
+	}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, fmt.Errorf("invalid fixture search.json: %w", err)
+	}
+
+	return out.Issues, nil
+}
+
+func (f *FixtureFetcher) decodeIssue(data []byte, opts ...filter.Filter) (*jira.Issue, error) {
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		var iss jira.Issue
+		if err := json.Unmarshal(data, &iss); err != nil {
+			return nil, err
+		}
+		return &iss, nil
+	}
+	return jira.UnmarshalIssue(data, opts...)
+}
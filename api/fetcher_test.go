@@ -0,0 +1,117 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+	"github.com/jorres/jira-tui/pkg/jira/filter"
+)
+
+type pagedFetcher struct {
+	total int
+	calls []string
+}
+
+func (f *pagedFetcher) Search(_ string, from, limit uint) (*jira.SearchResult, error) {
+	f.calls = append(f.calls, fmt.Sprintf("%d:%d", from, limit))
+
+	out := &jira.SearchResult{StartAt: int(from), MaxResults: int(limit), Total: f.total}
+	for i := int(from); i < f.total && i < int(from+limit); i++ {
+		out.Issues = append(out.Issues, &jira.Issue{Key: fmt.Sprintf("TEST-%d", i+1)})
+	}
+	return out, nil
+}
+
+func (f *pagedFetcher) GetIssue(string, ...filter.Filter) (*jira.Issue, error) {
+	return nil, nil
+}
+
+func TestSearchAll(t *testing.T) {
+	f := &pagedFetcher{total: 250}
+	resp, err := SearchAll(f, "", 0, SearchAllCap)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Issues, 250)
+	assert.Equal(t, 250, resp.Total)
+	assert.Equal(t, "TEST-250", resp.Issues[249].Key)
+	assert.Equal(t, []string{"0:100", "100:100", "200:100"}, f.calls)
+
+	f = &pagedFetcher{total: 250}
+	resp, err = SearchAll(f, "", 10, 150)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Issues, 150)
+	assert.Equal(t, "TEST-11", resp.Issues[0].Key)
+	assert.Equal(t, []string{"10:100", "110:50"}, f.calls)
+
+	f = &pagedFetcher{total: 250}
+	resp, err = SearchAll(f, "", 0, 20)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Issues, 20)
+	assert.Equal(t, []string{"0:20"}, f.calls)
+}
+
+func TestDefaultFetcher(t *testing.T) {
+	var paths []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/search", "/rest/api/2/search":
+			_, _ = w.Write([]byte(`{"startAt": 0, "maxResults": 1, "total": 1, "issues": [{"key": "TEST-1", "fields": {"summary": "Login fails"}}]}`))
+		case "/rest/api/3/issue/TEST-1", "/rest/api/2/issue/TEST-1":
+			_, _ = w.Write([]byte(`{"key": "TEST-1", "fields": {"summary": "Login fails"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Cleanup(func() {
+		viper.Reset()
+		jiraClient = nil
+		fetcher = nil
+	})
+	viper.Set("server", server.URL)
+	viper.Set("login", "person@example.com")
+	viper.Set("api_token", "secret")
+
+	f := DefaultFetcher(false)
+	assert.IsType(t, &LiveFetcher{}, f)
+
+	resp, err := f.Search("project = TEST", 0, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, resp.Total)
+	assert.Equal(t, "TEST-1", resp.Issues[0].Key)
+
+	iss, err := f.GetIssue("TEST-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Login fails", iss.Fields.Summary)
+	assert.Equal(t, []string{"/rest/api/3/search", "/rest/api/3/issue/TEST-1"}, paths)
+
+	paths = nil
+	viper.Set("installation", jira.InstallationTypeLocal)
+	_, err = f.Search("project = TEST", 0, 1)
+	assert.NoError(t, err)
+	_, err = f.GetIssue("TEST-1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/rest/api/2/search", "/rest/api/2/issue/TEST-1"}, paths)
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "search.json"), []byte(`{"issues": [{"key": "FIX-1"}]}`), 0o600))
+	assert.NoError(t, UseFixtures(dir))
+	assert.True(t, IsUsingFixtures())
+
+	resp, err = DefaultFetcher(false).Search("", 0, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, "FIX-1", resp.Issues[0].Key)
+	assert.Len(t, paths, 2)
+}
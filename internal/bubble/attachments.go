@@ -0,0 +1,112 @@
+package bubble
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/charmbracelet/bubbles/v2/list"
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// getAttachmentsDir reads `ui.attachments.dir`, the directory attachments are
+// downloaded to, the working directory by default.
+func getAttachmentsDir() string {
+	dir, err := homedir.Expand(viper.GetString("ui.attachments.dir"))
+	if err != nil || dir == "" {
+		return "."
+	}
+	return dir
+}
+
+// attachmentItem allows for `Attachment` type to be passed to FuzzySelector.
+type attachmentItem struct {
+	jira.Attachment
+}
+
+func (a attachmentItem) FilterValue() string { return a.Filename }
+func (a attachmentItem) Title() string       { return a.Filename }
+func (a attachmentItem) Description() string {
+	return fmt.Sprintf("%s • %s • %s",
+		formatSize(a.Size), a.Author.DisplayName, FormatDateTime(a.Created, jira.RFC3339, "Local"))
+}
+
+// formatSize renders a size in bytes the way file managers do, e.g. 1.5 MB.
+func formatSize(size int) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := unit, 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(size)/float64(div), "KMGT"[exp])
+}
+
+func attachmentsToItems(attachments []jira.Attachment) []list.Item {
+	listItems := []list.Item{}
+	for _, a := range attachments {
+		listItems = append(listItems, attachmentItem{a})
+	}
+	return listItems
+}
+
+// selectAttachment lists the attachments of the issue, enter downloads the
+// selected one and y copies its download URL.
+func (l *IssueList) selectAttachment(iss *jira.Issue) (tea.Model, tea.Cmd) {
+	fz := NewFuzzySelectorFrom(l, l.rawWidth, l.rawHeight, []list.Item{}, FuzzySelectorAttachment)
+	return fz, tea.Batch(fz.list.StartSpinner(), func() tea.Msg {
+		attachments, err := l.c.GetAttachments(iss.Key)
+		return AttachmentsMsg{issueKey: iss.Key, attachments: attachments, err: err}
+	})
+}
+
+// downloadAttachment saves the attachment to the attachments directory. An
+// existing file is not overwritten, the name gets a number instead.
+func (l *IssueList) downloadAttachment(a jira.Attachment) tea.Cmd {
+	return func() tea.Msg {
+		path, err := func() (string, error) {
+			dir := getAttachmentsDir()
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return "", err
+			}
+			f, err := createFree(dir, filepath.Base(a.Filename))
+			if err != nil {
+				return "", err
+			}
+			if err := l.c.DownloadAttachment(a, f); err != nil {
+				_ = f.Close()
+				_ = os.Remove(f.Name())
+				return "", err
+			}
+			if err := f.Close(); err != nil {
+				return "", err
+			}
+			return filepath.Abs(f.Name())
+		}()
+		return AttachmentDownloadedMsg{filename: a.Filename, path: path, err: err}
+	}
+}
+
+// createFree creates the file in dir, adding a number to the name if it is taken,
+// e.g. screenshot (1).png.
+func createFree(dir, name string) (*os.File, error) {
+	ext := filepath.Ext(name)
+	base := name[:len(name)-len(ext)]
+	for n := 0; ; n++ {
+		candidate := name
+		if n > 0 {
+			candidate = fmt.Sprintf("%s (%d)%s", base, n, ext)
+		}
+		f, err := os.OpenFile(filepath.Join(dir, candidate), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if !os.IsExist(err) {
+			return f, err
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package bubble
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+func TestFormatSize(t *testing.T) {
+	assert.Equal(t, "512 B", formatSize(512))
+	assert.Equal(t, "1.5 KB", formatSize(1536))
+	assert.Equal(t, "2.0 MB", formatSize(2*1024*1024))
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	dir := t.TempDir()
+	viper.Set("ui.attachments.dir", dir)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("PNG"))
+	}))
+	defer server.Close()
+
+	l := &IssueList{c: jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))}
+	a := jira.Attachment{Filename: "screenshot.png", Content: server.URL + "/attachment/content/10100"}
+
+	msg := l.downloadAttachment(a)().(AttachmentDownloadedMsg)
+	assert.NoError(t, msg.err)
+	assert.Equal(t, filepath.Join(dir, "screenshot.png"), msg.path)
+
+	// The first download is kept
+	msg = l.downloadAttachment(a)().(AttachmentDownloadedMsg)
+	assert.NoError(t, msg.err)
+	assert.Equal(t, filepath.Join(dir, "screenshot (1).png"), msg.path)
+
+	b, err := os.ReadFile(msg.path)
+	assert.NoError(t, err)
+	assert.Equal(t, "PNG", string(b))
+}
+
+func TestAttachmentSelector(t *testing.T) {
+	l := &IssueList{}
+
+	fz := NewFuzzySelectorFrom(l, 80, 24, nil, FuzzySelectorAttachment)
+	m, _ := fz.Update(AttachmentsMsg{issueKey: "TEST-1"})
+	assert.Same(t, l, m)
+	assert.Equal(t, "TEST-1 has no attachments", l.statusMessage)
+
+	fz = NewFuzzySelectorFrom(l, 80, 24, nil, FuzzySelectorAttachment)
+	m, _ = fz.Update(AttachmentsMsg{issueKey: "TEST-1", attachments: []jira.Attachment{{Filename: "log.txt", Size: 2048}}})
+	assert.Same(t, fz, m)
+	assert.Len(t, fz.list.Items(), 1)
+	assert.Contains(t, fz.list.Items()[0].(attachmentItem).Description(), "2.0 KB")
+}
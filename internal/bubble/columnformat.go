@@ -0,0 +1,191 @@
+package bubble
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// columnFormatter renders the value of a column for the issue.
+type columnFormatter func(t *Table, issue *jira.Issue) string
+
+// columnFormat lists the ways a column can be rendered, the first one is the default.
+type columnFormat struct {
+	names      []string
+	formatters map[string]columnFormatter
+}
+
+// timeNow is stubbed in tests of relative dates.
+var timeNow = time.Now
+
+// columnFormats is the registry of formats that can be picked per column with
+// `ui.list.column_formats`. Columns that are not listed have a single format.
+var columnFormats = map[string]columnFormat{
+	FieldAssignee: userFormat(func(issue *jira.Issue) (string, string) {
+		return issue.Fields.Assignee.Name, issue.Fields.Assignee.Email
+	}),
+	FieldReporter: userFormat(func(issue *jira.Issue) (string, string) {
+		return issue.Fields.Reporter.Name, issue.Fields.Reporter.Email
+	}),
+	FieldCreated: dateFormat(func(issue *jira.Issue) string { return issue.Fields.Created }),
+	FieldUpdated: dateFormat(func(issue *jira.Issue) string { return issue.Fields.Updated }),
+	FieldLabels:  listFormat(func(issue *jira.Issue) []string { return issue.Fields.Labels }),
+	FieldFixVersions: listFormat(func(issue *jira.Issue) []string {
+		versions := make([]string, 0, len(issue.Fields.FixVersions))
+		for _, v := range issue.Fields.FixVersions {
+			versions = append(versions, v.Name)
+		}
+		return versions
+	}),
+	FieldComponents: listFormat(func(issue *jira.Issue) []string {
+		components := make([]string, 0, len(issue.Fields.Components))
+		for _, c := range issue.Fields.Components {
+			components = append(components, c.Name)
+		}
+		return components
+	}),
+}
+
+// userFormat renders a user as "name" (the display name), "email" or
+// "initials". Jira Cloud hides emails by default, the name is shown instead.
+func userFormat(user func(*jira.Issue) (name, email string)) columnFormat {
+	return columnFormat{
+		names: []string{"name", "email", "initials"},
+		formatters: map[string]columnFormatter{
+			"name": func(_ *Table, issue *jira.Issue) string {
+				name, _ := user(issue)
+				return name
+			},
+			"email": func(_ *Table, issue *jira.Issue) string {
+				name, email := user(issue)
+				if email == "" {
+					return name
+				}
+				return email
+			},
+			"initials": func(_ *Table, issue *jira.Issue) string {
+				name, _ := user(issue)
+				return initials(name)
+			},
+		},
+	}
+}
+
+// dateFormat renders a date as "absolute" (date and time), "date" or "relative", e.g. "3d ago".
+func dateFormat(date func(*jira.Issue) string) columnFormat {
+	return columnFormat{
+		names: []string{"absolute", "date", "relative"},
+		formatters: map[string]columnFormatter{
+			"absolute": func(t *Table, issue *jira.Issue) string {
+				return FormatDateTime(date(issue), jira.RFC3339, t.timezone)
+			},
+			"date": func(t *Table, issue *jira.Issue) string {
+				dt := FormatDateTime(date(issue), jira.RFC3339, t.timezone)
+				day, _, _ := strings.Cut(dt, " ")
+				return day
+			},
+			"relative": func(_ *Table, issue *jira.Issue) string {
+				dt, err := time.Parse(jira.RFC3339, date(issue))
+				if err != nil {
+					return date(issue)
+				}
+				return relativeTime(timeNow().Sub(dt))
+			},
+		},
+	}
+}
+
+// listFormat renders a list as "list" (comma separated), "count" or "first",
+// the first item followed by the number of the others.
+func listFormat(items func(*jira.Issue) []string) columnFormat {
+	return columnFormat{
+		names: []string{"list", "count", "first"},
+		formatters: map[string]columnFormatter{
+			"list": func(_ *Table, issue *jira.Issue) string {
+				return strings.Join(items(issue), ",")
+			},
+			"count": func(_ *Table, issue *jira.Issue) string {
+				if n := len(items(issue)); n > 0 {
+					return fmt.Sprint(n)
+				}
+				return ""
+			},
+			"first": func(_ *Table, issue *jira.Issue) string {
+				all := items(issue)
+				switch len(all) {
+				case 0:
+					return ""
+				case 1:
+					return all[0]
+				}
+				return fmt.Sprintf("%s +%d", all[0], len(all)-1)
+			},
+		},
+	}
+}
+
+// getColumnFormats reads `ui.list.column_formats`, the format picked for each
+// column. Unknown columns and formats are left out, so the default is used.
+func getColumnFormats() map[string]string {
+	var raw map[string]string
+	if err := viper.UnmarshalKey("ui.list.column_formats", &raw); err != nil {
+		return nil
+	}
+
+	formats := make(map[string]string, len(raw))
+	for column, name := range raw {
+		column, name = strings.ToUpper(column), strings.ToLower(name)
+		if _, ok := columnFormats[column].formatters[name]; ok {
+			formats[column] = name
+		}
+	}
+	return formats
+}
+
+// formatColumn renders the column with the format configured for it, it
+// returns false if the column isn't in the registry.
+func (t *Table) formatColumn(column string, issue *jira.Issue) (string, bool) {
+	cf, ok := columnFormats[column]
+	if !ok {
+		return "", false
+	}
+	f, ok := cf.formatters[t.columnFormats[column]]
+	if !ok {
+		f = cf.formatters[cf.names[0]]
+	}
+	return f(t, issue), true
+}
+
+// initials turns "Jane van Doe" into "JVD".
+func initials(name string) string {
+	var out strings.Builder
+	for _, word := range strings.Fields(name) {
+		r := []rune(word)[0]
+		out.WriteRune(unicode.ToUpper(r))
+	}
+	return out.String()
+}
+
+// relativeTime renders the duration in its largest unit, e.g. "3h ago".
+func relativeTime(d time.Duration) string {
+	const day = 24 * time.Hour
+
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", d/time.Minute)
+	case d < day:
+		return fmt.Sprintf("%dh ago", d/time.Hour)
+	case d < 30*day:
+		return fmt.Sprintf("%dd ago", d/day)
+	case d < 365*day:
+		return fmt.Sprintf("%dmo ago", d/(30*day))
+	}
+	return fmt.Sprintf("%dy ago", d/(365*day))
+}
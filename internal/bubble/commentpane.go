@@ -0,0 +1,142 @@
+package bubble
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/v2/textarea"
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/internal/editing"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// CommentPane collects a new comment in a popup over the list, so that the
+// UI doesn't leave the alt screen for an editor. The comment is markdown.
+type CommentPane struct {
+	RawWidth  int
+	RawHeight int
+
+	issueKey string
+	internal bool
+	input    textarea.Model
+
+	PreviousModel *IssueList
+}
+
+// NewCommentPane opens the pane for a comment on the issue, prefilled with the quote if any.
+func NewCommentPane(prev *IssueList, issueKey, quote string, internal bool, width, height int) *CommentPane {
+	input := textarea.New()
+	input.ShowLineNumbers = false
+	input.MaxHeight = 0
+	input.Placeholder = "Write a comment in markdown, @email mentions a user"
+	input.SetValue(quote)
+
+	p := &CommentPane{
+		issueKey:      issueKey,
+		internal:      internal,
+		input:         input,
+		PreviousModel: prev,
+	}
+	p.resize(width, height)
+	return p
+}
+
+func (p *CommentPane) resize(width, height int) {
+	p.RawWidth = width
+	p.RawHeight = height
+
+	// The popup takes 80% of the screen, the border, title and hint take 5 rows
+	p.input.SetWidth(int(float32(width) * 0.8))
+	p.input.SetHeight(max(int(float32(height)*0.8)-5, 3))
+}
+
+func (p *CommentPane) Init() tea.Cmd {
+	return p.input.Focus()
+}
+
+func (p *CommentPane) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		p.resize(msg.Width, msg.Height)
+		return p, nil
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc":
+			return p.close(p.PreviousModel.setStatusMessage("Comment discarded"))
+		case "ctrl+d":
+			body := strings.TrimSpace(p.input.Value())
+			if body == "" {
+				return p.close(p.PreviousModel.setStatusMessage("Empty comment discarded"))
+			}
+			return p.close(tea.Batch(
+				p.PreviousModel.setStatusMessage(fmt.Sprintf("Adding comment to %s...", p.issueKey)),
+				p.PreviousModel.postComment(p.issueKey, body, p.internal),
+			))
+		}
+	}
+
+	var cmd tea.Cmd
+	p.input, cmd = p.input.Update(msg)
+	return p, cmd
+}
+
+// close returns to the list, which is laid out again for the current window size.
+func (p *CommentPane) close(cmd tea.Cmd) (tea.Model, tea.Cmd) {
+	width, height := p.RawWidth, p.RawHeight
+	return p.PreviousModel, tea.Batch(cmd, func() tea.Msg {
+		return tea.WindowSizeMsg{Width: width, Height: height}
+	})
+}
+
+func (p *CommentPane) View() string {
+	title := fmt.Sprintf("New comment on %s", p.issueKey)
+	if p.internal {
+		title = fmt.Sprintf("New internal comment on %s", p.issueKey)
+	}
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(getAccentColor()))
+	hintStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(getPaleColor()))
+
+	popup := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color(getAccentColor())).
+		Render(lipgloss.JoinVertical(lipgloss.Left,
+			titleStyle.Render(title),
+			p.input.View(),
+			hintStyle.Render("ctrl+d: submit • esc: cancel"),
+		))
+
+	return lipgloss.Place(p.RawWidth, p.RawHeight, lipgloss.Center, lipgloss.Center, popup)
+}
+
+// postComment converts the markdown comment for the installation and adds it to the issue.
+func (l *IssueList) postComment(issueKey, body string, internal bool) tea.Cmd {
+	index := l.activeTab
+	return func() tea.Msg {
+		err := func() error {
+			translator, err := editing.PrepareMD2AdfTranslator(body, l.c, issueKey, nil)
+			if err != nil {
+				return err
+			}
+
+			if viper.GetString("installation") == jira.InstallationTypeLocal {
+				if err := translator.CheckSafeForV2(body); err != nil {
+					return jira.V3ContentToV2EndpointError(err)
+				}
+				return l.c.AddIssueCommentV2(issueKey, body, internal)
+			}
+
+			adfDoc, err := editing.TranslateToADF(translator, body)
+			if err != nil {
+				return fmt.Errorf("failed to convert your new comment to ADF: %w", err)
+			}
+			return l.c.AddIssueComment(issueKey, adfDoc, internal)
+		}()
+		if err != nil {
+			return CommentAddedMsg{issueKey: issueKey, index: index, err: err, stderr: err.Error()}
+		}
+		return CommentAddedMsg{issueKey: issueKey, index: index}
+	}
+}
@@ -0,0 +1,45 @@
+package bubble
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommentPane(t *testing.T) {
+	t.Run("esc discards the comment", func(t *testing.T) {
+		l := &IssueList{}
+		p := NewCommentPane(l, "TEST-1", "> quoted\n", false, 80, 24)
+
+		m, cmd := p.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+		assert.Equal(t, l, m)
+		assert.NotNil(t, cmd)
+		assert.Equal(t, "Comment discarded", l.statusMessage)
+	})
+
+	t.Run("ctrl+d on an empty comment posts nothing", func(t *testing.T) {
+		l := &IssueList{}
+		p := NewCommentPane(l, "TEST-1", "", false, 80, 24)
+
+		m, _ := p.Update(tea.KeyPressMsg{Code: 'd', Mod: tea.ModCtrl})
+		assert.Equal(t, l, m)
+		assert.Equal(t, "Empty comment discarded", l.statusMessage)
+	})
+
+	t.Run("ctrl+d submits the comment", func(t *testing.T) {
+		l := &IssueList{}
+		p := NewCommentPane(l, "TEST-1", "", true, 80, 24)
+		p.Init()
+
+		m, _ := p.Update(tea.KeyPressMsg{Code: 'h', Text: "h"})
+		m, _ = m.Update(tea.KeyPressMsg{Code: 'i', Text: "i"})
+		assert.Equal(t, "hi", p.input.Value())
+		assert.Contains(t, p.View(), "New internal comment on TEST-1")
+
+		m, cmd := m.Update(tea.KeyPressMsg{Code: 'd', Mod: tea.ModCtrl})
+		assert.Equal(t, l, m)
+		assert.NotNil(t, cmd)
+		assert.Equal(t, "Adding comment to TEST-1...", l.statusMessage)
+	})
+}
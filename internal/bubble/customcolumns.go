@@ -0,0 +1,62 @@
+package bubble
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// customFieldPrefix starts the id of every custom field, e.g. customfield_10020.
+const customFieldPrefix = "customfield_"
+
+// configuredCustomField is a custom field listed in `issue.fields.custom`.
+type configuredCustomField struct {
+	Name string `mapstructure:"name"`
+	Key  string `mapstructure:"key"`
+}
+
+// getConfiguredCustomFields reads the custom fields written to the config by `jira init`.
+func getConfiguredCustomFields() []configuredCustomField {
+	var fields []configuredCustomField
+	if err := viper.UnmarshalKey("issue.fields.custom", &fields); err != nil {
+		return nil
+	}
+	return fields
+}
+
+// customColumns resolves the columns that are custom fields, given by id or
+// by their name in `issue.fields.custom`. It maps the header of each column
+// to the id of the field, the header is the configured name when there is one.
+func customColumns(columns []string) map[string]string {
+	configured := getConfiguredCustomFields()
+
+	out := make(map[string]string)
+columns:
+	for _, c := range columns {
+		if slices.Contains(ValidIssueColumns(), strings.ToUpper(c)) {
+			continue
+		}
+
+		for _, f := range configured {
+			if strings.EqualFold(f.Name, c) || strings.EqualFold(f.Key, c) {
+				out[strings.ToUpper(f.Name)] = f.Key
+				continue columns
+			}
+		}
+		if strings.HasPrefix(strings.ToLower(c), customFieldPrefix) {
+			out[strings.ToUpper(c)] = strings.ToLower(c)
+		}
+	}
+	return out
+}
+
+// customColumnHeader returns the header the column is shown with if it is a custom field.
+func (t *Table) customColumnHeader(column string) (string, bool) {
+	for header, id := range t.customColumns {
+		if strings.EqualFold(header, column) || strings.EqualFold(id, column) {
+			return header, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,67 @@
+package bubble
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/spf13/viper"
+)
+
+// childEnvKeys are the connection settings forwarded to child invocations through
+// JIRA_* environment variables, they have no command line flags of their own.
+var childEnvKeys = []string{"server", "login", "installation", "auth_type", "insecure"}
+
+// childArgs prepends to args the global flags needed for a child `jira` invocation
+// to run against the same config, project, debug and color modes as the UI itself.
+// NO_COLOR is inherited with the rest of the environment.
+func childArgs(args ...string) []string {
+	out := []string{}
+
+	config := viper.ConfigFileUsed()
+	if config == "" {
+		config = viper.GetString("config")
+	}
+	if config != "" {
+		out = append(out, "-c", config)
+	}
+	if project := viper.GetString("project.key"); project != "" {
+		out = append(out, "-p", project)
+	}
+	if viper.GetBool("debug") {
+		out = append(out, "--debug")
+	}
+	if viper.GetBool("no_color") {
+		out = append(out, "--no-color")
+	}
+
+	return append(out, args...)
+}
+
+// childEnv returns the environment for a child `jira` invocation, pinning
+// the connection settings resolved by the UI, e.g. from env overrides.
+func childEnv() []string {
+	env := os.Environ()
+	for _, key := range childEnvKeys {
+		if !viper.IsSet(key) {
+			continue
+		}
+		env = append(env, fmt.Sprintf("JIRA_%s=%s", strings.ToUpper(key), viper.GetString(key)))
+	}
+	return env
+}
+
+// execCommandWithStderr executes a command and captures both stdout and stderr
+func execCommandWithStderr(args []string, msgConstructor func(error, string) tea.Msg) tea.Cmd {
+	c := exec.Command("jira", args...)
+	c.Env = childEnv()
+	var stderr bytes.Buffer
+	c.Stderr = &stderr
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		stderrOutput := stderr.String()
+		return msgConstructor(err, stderrOutput)
+	})
+}
@@ -0,0 +1,39 @@
+package bubble
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChildArgs(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	viper.Set("config", "/tmp/jira.yml")
+	viper.Set("project.key", "PRJ")
+	viper.Set("debug", true)
+	viper.Set("no_color", true)
+
+	assert.Equal(t,
+		[]string{"-c", "/tmp/jira.yml", "-p", "PRJ", "--debug", "--no-color", "issue", "edit", "PRJ-1"},
+		childArgs("issue", "edit", "PRJ-1"),
+	)
+
+	viper.Reset()
+
+	assert.Equal(t, []string{"issue", "move", "PRJ-1"}, childArgs("issue", "move", "PRJ-1"))
+}
+
+func TestChildEnv(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	viper.Set("server", "https://jira.example.com")
+	viper.Set("installation", "Local")
+
+	env := childEnv()
+
+	assert.Contains(t, env, "JIRA_SERVER=https://jira.example.com")
+	assert.Contains(t, env, "JIRA_INSTALLATION=Local")
+	assert.NotContains(t, env, "JIRA_LOGIN=")
+}
@@ -0,0 +1,207 @@
+package bubble
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/viper"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+const (
+	exportFormatMarkdown = "markdown"
+	exportFormatHTML     = "html"
+)
+
+// getExportFormat reads `ui.export.format`, reports are written as markdown
+// unless it is "html".
+func getExportFormat() string {
+	if strings.EqualFold(viper.GetString("ui.export.format"), exportFormatHTML) {
+		return exportFormatHTML
+	}
+	return exportFormatMarkdown
+}
+
+// getExportDir reads `ui.export.dir`, the directory reports are written to,
+// the working directory by default.
+func getExportDir() string {
+	dir, err := homedir.Expand(viper.GetString("ui.export.dir"))
+	if err != nil || dir == "" {
+		return "."
+	}
+	return dir
+}
+
+// exportReport is a snapshot of a tab: the issues listed, as filtered, and
+// the issue shown in the detail pane.
+type exportReport struct {
+	title   string
+	project string
+	server  string
+	filter  string
+	at      time.Time
+
+	data  TableData
+	issue *jira.Issue
+}
+
+// exportView writes a report of the current tab to a file. The table is read
+// right away, the report is written in the background.
+func (l *IssueList) exportView() tea.Cmd {
+	table := l.getCurrentTable()
+	if table == nil || table.allIssues == nil {
+		return l.setStatusMessage("Nothing to export yet")
+	}
+
+	r := exportReport{
+		title:   l.getCurrentTabConfig().Name,
+		project: l.getCurrentTabConfig().Project,
+		server:  l.Server,
+		at:      timeNow(),
+		data:    table.makeTableData(table.issuePool()),
+		issue:   l.getCurrentIssueDetailView().Data,
+	}
+	if table.SorterState != SorterInactive {
+		r.filter = table.sorterText
+	}
+
+	format, dir := getExportFormat(), getExportDir()
+	return func() tea.Msg {
+		path, err := r.write(dir, format)
+		return ViewExportedMsg{path: path, issues: len(r.data) - 1, err: err}
+	}
+}
+
+// markdown renders the report, issue keys link to the server.
+func (r exportReport) markdown() string {
+	var s strings.Builder
+
+	fmt.Fprintf(&s, "# %s\n\n", r.title)
+
+	meta := fmt.Sprintf("%s · %d issue(s) · exported %s", r.project, max(len(r.data)-1, 0), r.at.Format("2006-01-02 15:04"))
+	if r.filter != "" {
+		meta += fmt.Sprintf(" · filtered by %q", r.filter)
+	}
+	s.WriteString(meta + "\n")
+
+	if len(r.data) > 0 {
+		keyIdx := slices.Index(r.data[0], FieldKey)
+
+		s.WriteString("\n" + markdownRow(r.data[0]))
+		s.WriteString(strings.Repeat("| --- ", len(r.data[0])) + "|\n")
+		for _, row := range r.data[1:] {
+			row = slices.Clone(row)
+			if keyIdx >= 0 {
+				key := strings.TrimPrefix(row[keyIdx], markPrefix)
+				row[keyIdx] = fmt.Sprintf("[%s](%s)", key, r.browseURL(key))
+			}
+			s.WriteString(markdownRow(row))
+		}
+	}
+
+	if r.issue != nil {
+		iss := r.issue
+		fmt.Fprintf(&s, "\n## [%s](%s): %s\n\n", iss.Key, r.browseURL(iss.Key), iss.Fields.Summary)
+
+		assignee := iss.Fields.Assignee.Name
+		if assignee == "" {
+			assignee = "Unassigned"
+		}
+		fmt.Fprintf(&s, "**Status:** %s · **Assignee:** %s · **Priority:** %s\n",
+			iss.Fields.Status.Name, assignee, iss.Fields.Priority.Name)
+
+		m := NewIssueModel(r.server)
+		m.Data = iss
+		if desc := strings.TrimSpace(m.description()); desc != "" {
+			s.WriteString("\n" + desc + "\n")
+		}
+	}
+
+	return s.String()
+}
+
+func (r exportReport) browseURL(key string) string {
+	return fmt.Sprintf("%s/browse/%s", strings.TrimSuffix(r.server, "/"), key)
+}
+
+// markdownRow renders the cells as a row of a markdown table.
+func markdownRow(cells []string) string {
+	escaped := make([]string, len(cells))
+	for i, c := range cells {
+		escaped[i] = strings.ReplaceAll(c, "|", `\|`)
+	}
+	return "| " + strings.Join(escaped, " | ") + " |\n"
+}
+
+// exportHTML is the page an HTML report is embedded in, styled inline so
+// that the file can be shared on its own.
+const exportHTML = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>%s</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em auto; max-width: 1100px; color: #24292f; }
+table { border-collapse: collapse; width: 100%%; }
+th, td { border: 1px solid #d0d7de; padding: 4px 8px; text-align: left; }
+th { background: #f6f8fa; }
+pre, code { background: #f6f8fa; }
+a { color: #0969da; }
+</style>
+</head>
+<body>
+%s</body>
+</html>
+`
+
+// markdownToHTML renders the markdown report as a self-contained HTML page.
+// Raw HTML in descriptions is left out.
+func markdownToHTML(title, markdown string) (string, error) {
+	var body bytes.Buffer
+	if err := goldmark.New(goldmark.WithExtensions(extension.GFM)).Convert([]byte(markdown), &body); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(exportHTML, html.EscapeString(title), body.String()), nil
+}
+
+var nonSlugChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// write saves the report in dir as e.g. jira-sprint-board-20250510-120000.md,
+// it returns the absolute path of the file.
+func (r exportReport) write(dir, format string) (string, error) {
+	content, ext := r.markdown(), ".md"
+	if format == exportFormatHTML {
+		var err error
+		if content, err = markdownToHTML(r.title, content); err != nil {
+			return "", err
+		}
+		ext = ".html"
+	}
+
+	slug := strings.Trim(nonSlugChars.ReplaceAllString(strings.ToLower(r.title), "-"), "-")
+	name := fmt.Sprintf("jira-%s-%s%s", slug, r.at.Format("20060102-150405"), ext)
+	if slug == "" {
+		name = fmt.Sprintf("jira-%s%s", r.at.Format("20060102-150405"), ext)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	path, err := filepath.Abs(filepath.Join(dir, name))
+	if err != nil {
+		return "", err
+	}
+	return path, os.WriteFile(path, []byte(content), 0o644)
+}
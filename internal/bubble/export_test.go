@@ -0,0 +1,59 @@
+package bubble
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+func TestExportReport(t *testing.T) {
+	iss := &jira.Issue{Key: "TEST-2"}
+	iss.Fields.Summary = "Fix login"
+	iss.Fields.Status.Name = "In Progress"
+	iss.Fields.Priority.Name = "High"
+	iss.Fields.Description = "Users can't log in"
+
+	r := exportReport{
+		title:   "Sprint | Board",
+		project: "TEST",
+		server:  "https://jira.example.com",
+		filter:  "login",
+		at:      time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC),
+		data: TableData{
+			{FieldKey, FieldSummary},
+			{markPrefix + "TEST-2", "Fix login | SSO"},
+		},
+		issue: iss,
+	}
+
+	assert.Equal(t, `# Sprint | Board
+
+TEST · 1 issue(s) · exported 2025-05-10 12:00 · filtered by "login"
+
+| KEY | SUMMARY |
+| --- | --- |
+| [TEST-2](https://jira.example.com/browse/TEST-2) | Fix login \| SSO |
+
+## [TEST-2](https://jira.example.com/browse/TEST-2): Fix login
+
+**Status:** In Progress · **Assignee:** Unassigned · **Priority:** High
+
+Users can't log in
+`, r.markdown())
+
+	dir := t.TempDir()
+	path, err := r.write(dir, exportFormatHTML)
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "jira-sprint-board-20250510-120000.html"), path)
+
+	b, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), "<title>Sprint | Board</title>")
+	assert.Contains(t, string(b), `<td><a href="https://jira.example.com/browse/TEST-2">TEST-2</a></td>`)
+	assert.Contains(t, string(b), "<td>Fix login | SSO</td>")
+}
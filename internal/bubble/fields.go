@@ -1,19 +1,22 @@
 package bubble
 
 const (
-	FieldType       = "TYPE"
-	FieldParent     = "PARENT"
-	FieldKey        = "KEY"
-	FieldSummary    = "SUMMARY"
-	FieldStatus     = "STATUS"
-	FieldAssignee   = "ASSIGNEE"
-	FieldReporter   = "REPORTER"
-	FieldPriority   = "PRIORITY"
-	FieldResolution = "RESOLUTION"
-	FieldCreated    = "CREATED"
-	FieldUpdated    = "UPDATED"
-	FieldLabels     = "LABELS"
-	FieldIsOnBoard  = "IS ON BOARD"
+	FieldType        = "TYPE"
+	FieldParent      = "PARENT"
+	FieldKey         = "KEY"
+	FieldSummary     = "SUMMARY"
+	FieldStatus      = "STATUS"
+	FieldAssignee    = "ASSIGNEE"
+	FieldReporter    = "REPORTER"
+	FieldPriority    = "PRIORITY"
+	FieldResolution  = "RESOLUTION"
+	FieldCreated     = "CREATED"
+	FieldUpdated     = "UPDATED"
+	FieldLabels      = "LABELS"
+	FieldFixVersions = "FIX VERSIONS"
+	FieldComponents  = "COMPONENTS"
+	FieldBlocked     = "BLOCKED"
+	FieldIsOnBoard   = "IS ON BOARD"
 )
 
 // ValidIssueColumns returns the list of valid column names for help text
@@ -21,6 +24,7 @@ func ValidIssueColumns() []string {
 	return []string{
 		FieldType, FieldParent, FieldKey, FieldSummary, FieldStatus,
 		FieldAssignee, FieldReporter, FieldPriority, FieldResolution,
-		FieldCreated, FieldUpdated, FieldLabels, FieldIsOnBoard,
+		FieldCreated, FieldUpdated, FieldLabels, FieldFixVersions, FieldComponents,
+		FieldBlocked, FieldIsOnBoard,
 	}
 }
@@ -0,0 +1,89 @@
+package bubble
+
+import (
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// Matches are underlined with plain SGR toggles rather than a lipgloss style,
+// a full reset would also drop the styling of the selected row.
+const (
+	matchStart = "\x1b[4m"
+	matchEnd   = "\x1b[24m"
+)
+
+// filterMatch is where the filter matched the summary of an issue.
+type filterMatch struct {
+	summary string
+	// matched are the byte offsets of the matched characters in summary
+	matched []int
+}
+
+// newFilterMatch keeps the matched indexes that fall in the summary part of
+// the filter target, which starts at offset.
+func newFilterMatch(target string, matched []int, offset int) filterMatch {
+	m := filterMatch{summary: target[offset:]}
+	for _, i := range matched {
+		if i >= offset {
+			m.matched = append(m.matched, i-offset)
+		}
+	}
+	return m
+}
+
+// highlightSummary returns the summary as the table shows it in a column of
+// width cells, and the same with the matched characters underlined.
+func highlightSummary(summary string, matched []int, width int) (string, string) {
+	shown := runewidth.Truncate(summary, width, "…")
+	visible := strings.TrimSuffix(shown, "…")
+	if len(shown) == len(summary) {
+		visible = shown
+	}
+
+	isMatch := make(map[int]bool, len(matched))
+	for _, i := range matched {
+		isMatch[i] = true
+	}
+
+	var out strings.Builder
+	inMatch := false
+	for i, r := range visible {
+		if isMatch[i] != inMatch {
+			inMatch = !inMatch
+			if inMatch {
+				out.WriteString(matchStart)
+			} else {
+				out.WriteString(matchEnd)
+			}
+		}
+		out.WriteRune(r)
+	}
+	if inMatch {
+		out.WriteString(matchEnd)
+	}
+	out.WriteString(shown[len(visible):])
+
+	return shown, out.String()
+}
+
+// highlightFilterMatches underlines the matched characters of the summaries in
+// the rendered table. Rows are told apart by the keys they show, the one whose
+// summary is on the line is the issue of the row.
+func (t *Table) highlightFilterMatches(view string) string {
+	lines := strings.Split(view, "\n")
+	for n, line := range lines {
+		for _, key := range issueKeyRegexp.FindAllString(line, -1) {
+			m, ok := t.filterMatches[key]
+			if !ok || len(m.matched) == 0 {
+				continue
+			}
+			shown, highlighted := highlightSummary(m.summary, m.matched, t.summaryWidth)
+			if shown != "" && strings.Contains(line, shown) {
+				lines[n] = strings.Replace(line, shown, highlighted, 1)
+				break
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -17,6 +17,15 @@ type FuzzySelectorType int
 const (
 	FuzzySelectorEpic FuzzySelectorType = iota
 	FuzzySelectorUser
+	FuzzySelectorComment
+	FuzzySelectorProject
+	FuzzySelectorQuery
+	FuzzySelectorCommentAuthor
+	FuzzySelectorTransition
+	FuzzySelectorResolution
+	FuzzySelectorIssueType
+	FuzzySelectorCopyComment
+	FuzzySelectorAttachment
 )
 
 type FuzzySelector struct {
@@ -47,15 +56,45 @@ func (m *FuzzySelector) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.RawWidth = msg.Width
 		m.RawHeight = msg.Height
 		m.calculateViewportDimensions()
+	case AssignableUsersMsg:
+		return m.itemsLoaded(msg, msg.err, usersToItems(msg.users))
+	case ProjectsMsg:
+		return m.itemsLoaded(msg, msg.err, projectsToItems(msg.projects))
+	case TransitionsMsg:
+		return m.itemsLoaded(msg, msg.err, transitionsToItems(msg.transitions))
+	case ResolutionsMsg:
+		return m.itemsLoaded(msg, msg.err, resolutionsToItems(msg.resolutions))
+	case IssueTypesMsg:
+		return m.itemsLoaded(msg, msg.err, issueTypesToItems(msg.types, msg.current))
+	case AttachmentsMsg:
+		if msg.err == nil && len(msg.attachments) == 0 {
+			// nothing to pick from, the list tells so
+			return m.PreviousModel.Update(msg)
+		}
+		return m.itemsLoaded(msg, msg.err, attachmentsToItems(msg.attachments))
 	case tea.KeyMsg:
 		switch msg.String() {
 		case "ctrl+c", "q", "esc":
 			return m.PreviousModel, cmd
+		case "y":
+			if m.selectorType == FuzzySelectorAttachment && m.list.FilterState() != list.Filtering && m.list.SelectedItem() != nil {
+				return m.PreviousModel, func() tea.Msg {
+					return FuzzySelectorResultMsg{
+						item:         m.list.SelectedItem(),
+						selectorType: m.selectorType,
+						yank:         true,
+					}
+				}
+			}
 		case "enter":
 			// if we are currently filtering, first "enter" should apply
 			// filtering to the underlying list model and only subsequent "enter"
 			// should return selected issue to previous view
 			if m.list.FilterState() != list.Filtering {
+				// nothing to select yet, e.g. while the items are loading
+				if m.list.SelectedItem() == nil {
+					return m, nil
+				}
 				return m.PreviousModel, func() tea.Msg {
 					return FuzzySelectorResultMsg{
 						item:         m.list.SelectedItem(),
@@ -70,6 +109,18 @@ func (m *FuzzySelector) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+// itemsLoaded fills the selector opened before its items were fetched.
+// The list caches the items and shows the error if any, so it gets the message first.
+func (m *FuzzySelector) itemsLoaded(msg tea.Msg, err error, items []list.Item) (tea.Model, tea.Cmd) {
+	prev, cmd := m.PreviousModel.Update(msg)
+	if err != nil {
+		return prev, cmd
+	}
+	m.PreviousModel = prev
+	m.list.StopSpinner()
+	return m, tea.Batch(cmd, m.list.SetItems(items))
+}
+
 func (m *FuzzySelector) calculateViewportDimensions() {
 	// Calculate viewport with 10% margins
 	m.viewportWidth = int(float32(m.RawWidth) * 0.9)
@@ -107,6 +158,24 @@ func NewFuzzySelectorFrom(prev tea.Model, width, height int, items []list.Item,
 		fz.list.Title = "Select an epic to assign to:"
 	case FuzzySelectorUser:
 		fz.list.Title = "Assign this issue to:"
+	case FuzzySelectorComment:
+		fz.list.Title = "Quote a comment in your reply:"
+	case FuzzySelectorProject:
+		fz.list.Title = "Switch to project:"
+	case FuzzySelectorQuery:
+		fz.list.Title = "Open saved query:"
+	case FuzzySelectorCommentAuthor:
+		fz.list.Title = "Show comments by:"
+	case FuzzySelectorTransition:
+		fz.list.Title = "Move issue to:"
+	case FuzzySelectorResolution:
+		fz.list.Title = "Resolution:"
+	case FuzzySelectorIssueType:
+		fz.list.Title = "Change issue type to:"
+	case FuzzySelectorCopyComment:
+		fz.list.Title = "Copy a comment to the clipboard:"
+	case FuzzySelectorAttachment:
+		fz.list.Title = "Attachments, enter: download, y: copy URL"
 	}
 	fz.calculateViewportDimensions()
 
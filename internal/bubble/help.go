@@ -150,7 +150,21 @@ func (h *HelpView) prepareRenderedLines() {
 	navItems := []string{
 		"  " + keyStyle.Render("j/↓ k/↑") + "           " + descStyle.Render("Move cursor down/up"),
 		"  " + keyStyle.Render("CTRL+e/y") + "          " + descStyle.Render("Scroll content up/down"),
+		"  " + keyStyle.Render("click wheel") + "       " + descStyle.Render("Select a row/scroll the issue, with ui.mouse set"),
 		"  " + keyStyle.Render("left/h right/l") + "    " + descStyle.Render("Switch between tabs (if multiple)"),
+		"  " + keyStyle.Render("alt+1-9") + "           " + descStyle.Render("Jump to a tab, also 1-9 without quick filters"),
+		"  " + keyStyle.Render("alt+h alt+l") + "       " + descStyle.Render("Move the tab left/right, also alt+left/right"),
+		"  " + keyStyle.Render("alt+x alt+u") + "       " + descStyle.Render("Hide the tab/show hidden tabs, saved on quit"),
+		"  " + keyStyle.Render("< >") + "               " + descStyle.Render("Scroll columns that don't fit, KEY stays in place"),
+		"  " + keyStyle.Render("tab shift+tab") + "     " + descStyle.Render("Cycle through links in issue, copying the URL"),
+		"  " + keyStyle.Render("C") + "                 " + descStyle.Render("Expand/collapse earlier 'C'omments"),
+		"  " + keyStyle.Render("F") + "                 " + descStyle.Render("'F'ilter comments by author"),
+		"  " + keyStyle.Render("L") + "                 " + descStyle.Render("Expand/collapse all 'L'abels and components"),
+		"  " + keyStyle.Render("D") + "                 " + descStyle.Render("Show/hide the 'D'etail pane below the list"),
+		"  " + keyStyle.Render("+ -") + "               " + descStyle.Render("Grow/shrink the list, also CTRL+down/up"),
+		"  " + keyStyle.Render("space") + "             " + descStyle.Render("Peek at the issue in a popup, esc to close"),
+		"  " + keyStyle.Render("P") + "                 " + descStyle.Render("Switch to another 'P'roject"),
+		"  " + keyStyle.Render("s") + "                 " + descStyle.Render("Open a 's'aved query in a new tab"),
 	}
 
 	issueActions := sectionTitleStyle.Render("Issue Actions:")
@@ -158,22 +172,37 @@ func (h *HelpView) prepareRenderedLines() {
 		"  " + keyStyle.Render("enter") + "             " + descStyle.Render("open issue in browser"),
 		"  " + keyStyle.Render("n") + "                 " + descStyle.Render("create 'n'ew issue"),
 		"  " + keyStyle.Render("e") + "                 " + descStyle.Render("'e'dit current issue"),
-		"  " + keyStyle.Render("m") + "                 " + descStyle.Render("'m'ove issue to different status"),
-		"  " + keyStyle.Render("c") + "                 " + descStyle.Render("add 'c'omment to issue"),
+		"  " + keyStyle.Render("r") + "                 " + descStyle.Render("'r'ename issue, editing only its summary"),
+		"  " + keyStyle.Render("T") + "                 " + descStyle.Render("change issue 'T'ype, e.g. Story to Bug"),
+		"  " + keyStyle.Render("m") + "                 " + descStyle.Render("'m'ove issue to different status, asks for a resolution if needed"),
+		"  " + keyStyle.Render("c") + "                 " + descStyle.Render("add 'c'omment to issue, quoting one or internal"),
+		"  " + keyStyle.Render("x") + "                 " + descStyle.Render("mark/unmark issue for a bulk action"),
+		"  " + keyStyle.Render("t") + "                 " + descStyle.Render("add a label ('t'ag) to marked issues, -label removes it"),
 		"  " + keyStyle.Render("b") + "                 " + descStyle.Render("toggle 'b'acklog/board state"),
+		"  " + keyStyle.Render("p") + "                 " + descStyle.Render("cycle issue 'p'riority"),
 		"  " + keyStyle.Render("u") + "                 " + descStyle.Render("copy issue 'u'rl to clipboard"),
+		"  " + keyStyle.Render("CTRL+k") + "            " + descStyle.Render("copy issue 'k'ey to clipboard"),
+		"  " + keyStyle.Render("y") + "                 " + descStyle.Render("'y'ank a comment to clipboard as markdown"),
+		"  " + keyStyle.Render("f") + "                 " + descStyle.Render("list attached 'f'iles, download one or copy its URL"),
 	}
 
 	assignment := sectionTitleStyle.Render("Assignment:")
 	assignItems := []string{
 		"  " + keyStyle.Render("a") + "                 " + descStyle.Render("change 'a'ssignee"),
+		"  " + keyStyle.Render("A") + "                 " + descStyle.Render("'A'ssign to me"),
+		"  " + keyStyle.Render("R") + "                 " + descStyle.Render("assign to the 'R'eporter"),
+		"  " + keyStyle.Render("alt+a") + "             " + descStyle.Render("assign back to the previous assignee"),
 		"  " + keyStyle.Render("CTRL+p") + "            " + descStyle.Render("assign to e'p'ic"),
+		"  " + keyStyle.Render("X") + "                 " + descStyle.Render("detach from parent or epic"),
 	}
 
 	other := sectionTitleStyle.Render("Other:")
 	otherItems := []string{
-		"  " + keyStyle.Render("/") + "                 " + descStyle.Render("Filter/search issues"),
+		"  " + keyStyle.Render("/") + "                 " + descStyle.Render("Fuzzy filter issues by key and summary"),
+		"  " + keyStyle.Render("1-9 0") + "             " + descStyle.Render("Apply/clear a quick filter (ui.quick_filters)"),
 		"  " + keyStyle.Render("CTRL+r") + "            " + descStyle.Render("Refresh current view"),
+		"  " + keyStyle.Render("M") + "                 " + descStyle.Render("Show the log of recent 'M'essages"),
+		"  " + keyStyle.Render("E") + "                 " + descStyle.Render("'E'xport the list and the issue shown to a report"),
 		"  " + keyStyle.Render("?") + "                 " + descStyle.Render("Toggle this help"),
 		"  " + keyStyle.Render("q/ESC/CTRL+c") + "      " + descStyle.Render("Quit"),
 	}
@@ -5,10 +5,16 @@ import (
 	"os"
 	"strings"
 	"time"
+	"unicode/utf8"
 
+	chromastyles "github.com/alecthomas/chroma/v2/styles"
 	"github.com/charmbracelet/glamour"
+	glamourstyles "github.com/charmbracelet/glamour/styles"
 	"github.com/fatih/color"
 	"github.com/mgutz/ansi"
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/internal/cmdutil"
 )
 
 func FormatDateTime(dt, format, tz string) string {
@@ -56,7 +62,7 @@ const (
 // MDRenderer constructs markdown renderer.
 func MDRenderer(lightOrDark string) (*glamour.TermRenderer, error) {
 	return glamour.NewTermRenderer(
-		glamour.WithStandardStyle(lightOrDark),
+		mdStyleOption(lightOrDark),
 		glamour.WithWordWrap(wordWrap),
 	)
 }
@@ -64,12 +70,50 @@ func MDRenderer(lightOrDark string) (*glamour.TermRenderer, error) {
 // MDRendererWithWidth constructs markdown renderer with custom width.
 func MDRendererWithWidth(lightOrDark string, width int) (*glamour.TermRenderer, error) {
 	return glamour.NewTermRenderer(
-		glamour.WithStandardStyle(lightOrDark),
+		mdStyleOption(lightOrDark),
 		glamour.WithWordWrap(width),
 	)
 }
 
+// mdStyle falls back to the colorless glamour style when colors are disabled.
+func mdStyle(lightOrDark string) string {
+	if !cmdutil.ColorEnabled() {
+		return "notty"
+	}
+	return lightOrDark
+}
+
+// mdStyleOption is the glamour style for lightOrDark, with code blocks
+// highlighted by the chroma style set in `ui.code_theme` if there is one.
+func mdStyleOption(lightOrDark string) glamour.TermRendererOption {
+	style := mdStyle(lightOrDark)
+	theme := getCodeTheme()
+	cfg, ok := glamourstyles.DefaultStyles[style]
+	if theme == "" || !ok || style == "notty" {
+		return glamour.WithStandardStyle(style)
+	}
+
+	custom := *cfg
+	// The built-in chroma rules take precedence over the theme, drop them.
+	custom.CodeBlock.Chroma = nil
+	custom.CodeBlock.Theme = theme
+	return glamour.WithStyles(custom)
+}
+
+// getCodeTheme reads `ui.code_theme`, the chroma style code blocks are
+// highlighted with. Unknown styles are ignored.
+func getCodeTheme() string {
+	theme := strings.ToLower(viper.GetString("ui.code_theme"))
+	if _, ok := chromastyles.Registry[theme]; !ok {
+		return ""
+	}
+	return theme
+}
+
 func coloredOut(msg string, clr color.Attribute, attrs ...color.Attribute) string {
+	if !cmdutil.ColorEnabled() {
+		return msg
+	}
 	c := color.New(clr).Add(attrs...)
 	return c.Sprint(msg)
 }
@@ -80,6 +124,9 @@ func xterm256() bool {
 }
 
 func gray(msg string) string {
+	if !cmdutil.ColorEnabled() {
+		return msg
+	}
 	if xterm256() {
 		return gray256(msg)
 	}
@@ -87,20 +134,32 @@ func gray(msg string) string {
 }
 
 func gray256(msg string) string {
+	if !cmdutil.ColorEnabled() {
+		return msg
+	}
 	return fmt.Sprintf("\x1b[38;5;242m%s\x1b[m", msg)
 }
 
 func shortenAndPad(msg string, limit int) string {
-	if limit > 1 && len(msg) > limit {
-		return msg[0:limit-1] + "…"
+	if limit > 1 && utf8.RuneCountInString(msg) > limit {
+		return shorten(msg, limit)
 	}
 	return pad(msg, limit)
 }
 
+// shorten cuts msg to limit characters, the last one being an ellipsis.
+func shorten(msg string, limit int) string {
+	runes := []rune(msg)
+	if limit <= 1 || len(runes) <= limit {
+		return msg
+	}
+	return string(runes[:limit-1]) + "…"
+}
+
 func pad(msg string, limit int) string {
 	var out strings.Builder
 	out.WriteString(msg)
-	for i := len(msg); i < limit; i++ {
+	for i := utf8.RuneCountInString(msg); i < limit; i++ {
 		out.WriteRune(' ')
 	}
 	return out.String()
@@ -0,0 +1,47 @@
+package bubble
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const goSnippet = "```go\nfunc main() {\n\treturn\n}\n```\n"
+
+// keywordColor finds the color code the func keyword is rendered with.
+var keywordColor = regexp.MustCompile(`\x1b\[([0-9;]+)mfunc`)
+
+func TestCodeBlockHighlighting(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	t.Cleanup(func() { viper.Set("ui.code_theme", nil) })
+
+	render := func() string {
+		r, err := MDRendererWithWidth("dark", 80)
+		require.NoError(t, err)
+		out, err := r.Render(goSnippet)
+		require.NoError(t, err)
+		return out
+	}
+
+	def := keywordColor.FindStringSubmatch(render())
+	require.NotNil(t, def, "func keyword is not highlighted")
+
+	viper.Set("ui.code_theme", "monokai")
+	themed := keywordColor.FindStringSubmatch(render())
+	require.NotNil(t, themed, "func keyword is not highlighted with the code theme")
+	assert.NotEqual(t, def[1], themed[1])
+
+	// unknown themes fall back to the default highlighting
+	viper.Set("ui.code_theme", "no-such-theme")
+	assert.Equal(t, def, keywordColor.FindStringSubmatch(render()))
+}
+
+func TestShortenAndPad(t *testing.T) {
+	assert.Equal(t, "Élan  ", shortenAndPad("Élan", 6))
+	assert.Equal(t, "Résumé", shortenAndPad("Résumé", 6))
+	assert.Equal(t, "Résu…", shortenAndPad("Résumé", 5))
+	assert.Equal(t, "ab  ", shortenAndPad("ab", 4))
+}
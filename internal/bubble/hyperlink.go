@@ -0,0 +1,39 @@
+package bubble
+
+import (
+	"regexp"
+
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/internal/cmdutil"
+)
+
+// issueKeyRegexp has no leading word boundary, the key may follow the m that
+// ends a styling escape sequence.
+var issueKeyRegexp = regexp.MustCompile(`[A-Z][A-Z0-9_]*-[0-9]+\b`)
+
+// getOSC8Links reads `ui.osc8_links`, if set issue keys in the list and the
+// detail view link to the issue in terminals that are known to support it.
+func getOSC8Links() bool {
+	return viper.GetBool("ui.osc8_links") && cmdutil.HyperlinksSupported()
+}
+
+// linkIssueKeys wraps the keys of the given issues found in out in hyperlinks
+// to their browse URLs, anything else looking like a key is left as is.
+func linkIssueKeys(out, server string, keys map[string]bool) string {
+	return issueKeyRegexp.ReplaceAllStringFunc(out, func(key string) string {
+		if !keys[key] {
+			return key
+		}
+		return cmdutil.Hyperlink(cmdutil.GenerateServerBrowseURL(server, key), key)
+	})
+}
+
+// issueKeys are the keys linked in the detail view: the issue's own and its parent's.
+func (iss *IssueModel) issueKeys() map[string]bool {
+	keys := map[string]bool{iss.Data.Key: true}
+	if iss.Data.Fields.Parent != nil {
+		keys[iss.Data.Fields.Parent.Key] = true
+	}
+	return keys
+}
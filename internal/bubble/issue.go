@@ -1,10 +1,13 @@
 package bubble
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/v2/spinner"
 	"github.com/charmbracelet/glamour"
@@ -16,6 +19,8 @@ import (
 
 	"github.com/jorres/jira-tui/internal/cmdutil"
 	"github.com/jorres/jira-tui/internal/debug"
+	"github.com/jorres/jira-tui/internal/editing"
+	"github.com/jorres/jira-tui/internal/history"
 	"github.com/jorres/jira-tui/pkg/jira"
 	"github.com/jorres/jira-tui/pkg/md"
 
@@ -27,6 +32,20 @@ var _ = debug.Debug
 
 const defaultSummaryLength = 73 // +1 to take ellipsis '…' into account.
 
+// getSummaryMaxLength reads `ui.summary_max_length`, the length summaries are
+// ellipsized to in the table and in the subtasks and linked issues, 0 if unset.
+func getSummaryMaxLength() int {
+	return max(viper.GetInt("ui.summary_max_length"), 0)
+}
+
+// detailSummaryLength is the length of the summaries listed in the issue.
+func detailSummaryLength() int {
+	if l := getSummaryMaxLength(); l > 0 {
+		return l
+	}
+	return defaultSummaryLength
+}
+
 type fragment struct {
 	Body  string
 	Parse bool
@@ -58,6 +77,9 @@ type IssueModel struct {
 	Data    *jira.Issue
 	Options IssueOption
 
+	// linkKeys renders the keys of the issue and its parent as hyperlinks
+	linkKeys bool
+
 	// Original window dimensions
 	RawWidth  int
 	RawHeight int
@@ -69,6 +91,8 @@ type IssueModel struct {
 	marginWidth  int
 	marginHeight int
 
+	renderWidth int // Word wrap width of the markdown renderer
+
 	contentHeight int // Content height (viewport minus border/padding)
 
 	// Scrolling state
@@ -85,6 +109,22 @@ type IssueModel struct {
 	uniqueLinkTextReplacement  string
 	nLinks                     int
 
+	// commentsExpanded shows all loaded comments instead of the latest few
+	commentsExpanded bool
+
+	// metadataExpanded shows all labels and components in a section of their own
+	metadataExpanded bool
+
+	// commentAuthor narrows the comments down to the ones of this author,
+	// empty shows all comments
+	commentAuthor string
+
+	// lastViewed is when the issue was last opened, the comments created after
+	// it are marked as new. Zero marks none.
+	lastViewed time.Time
+	// viewed holds when the issues were last opened, see loadViewed
+	viewed map[string]time.Time
+
 	// Spinner for loading state
 	spinner spinner.Model
 }
@@ -97,7 +137,7 @@ func (i *IssueModel) RenderedOut(renderer *glamour.TermRenderer) (string, error)
 
 	for _, p := range i.fragments() {
 		if p.Parse {
-			out, err := renderer.Render(p.Body)
+			out, err := renderMarkdown(renderer, p.Body, i.renderWidth)
 			if err != nil {
 				return "", err
 			}
@@ -115,6 +155,16 @@ func (i *IssueModel) fragments() []fragment {
 		{Body: i.header(), Parse: true},
 	}
 
+	if i.metadataExpanded && i.metadataTruncated() {
+		scraps = append(
+			scraps,
+			newBlankFragment(1),
+			fragment{Body: i.separator("Labels & Components")},
+			newBlankFragment(2),
+			fragment{Body: i.metadata(), Parse: true},
+		)
+	}
+
 	desc := i.description()
 	if desc != "" {
 		scraps = append(
@@ -137,25 +187,34 @@ func (i *IssueModel) fragments() []fragment {
 		)
 	}
 
-	if len(i.Data.Fields.IssueLinks) > 0 {
+	// Links to the parent or the subtasks only are not worth a section
+	if linked := i.linkedIssues(); linked != "" {
 		scraps = append(
 			scraps,
 			newBlankFragment(1),
 			fragment{Body: i.separator("Linked Issues")},
 			newBlankFragment(2),
-			fragment{Body: i.linkedIssues()},
+			fragment{Body: linked},
 			newBlankFragment(1),
 		)
 	}
 
 	if i.Data.Fields.Comment.Total > 0 && i.Options.NumComments > 0 {
-		scraps = append(
-			scraps,
-			newBlankFragment(1),
-			fragment{Body: i.separator(fmt.Sprintf("%d Comments", i.Data.Fields.Comment.Total))},
-			newBlankFragment(2),
-		)
-		for _, comment := range i.comments() {
+		scraps = append(scraps, newBlankFragment(1))
+		comments, hidden := i.visibleComments()
+
+		title := fmt.Sprintf("%d Comments", i.Data.Fields.Comment.Total)
+		if i.commentAuthor != "" {
+			title = fmt.Sprintf("%d Comments by %s (press F to change)", len(comments), i.commentAuthor)
+		}
+		scraps = append(scraps, fragment{Body: i.separator(title)}, newBlankFragment(2))
+		collapsed := fragment{Body: gray(fmt.Sprintf(" … %d earlier comments (press C to expand)", hidden))}
+
+		// earlier comments are collapsed above the visible ones when reading oldest first
+		if hidden > 0 && cmdutil.CommentsOldestFirst() {
+			scraps = append(scraps, collapsed, newBlankFragment(1))
+		}
+		for _, comment := range comments {
 			scraps = append(
 				scraps,
 				fragment{Body: comment.meta},
@@ -163,6 +222,9 @@ func (i *IssueModel) fragments() []fragment {
 				fragment{Body: comment.body, Parse: true},
 			)
 		}
+		if hidden > 0 && !cmdutil.CommentsOldestFirst() {
+			scraps = append(scraps, newBlankFragment(1), collapsed, newBlankFragment(1))
+		}
 	}
 
 	return append(scraps, newBlankFragment(1), fragment{Body: i.footer()}, newBlankFragment(2))
@@ -189,34 +251,29 @@ func (i *IssueModel) header() string {
 		as = "Unassigned"
 	}
 	st, sti := i.Data.Fields.Status.Name, "🚧"
-	if st == "Done" {
+	if cmdutil.IsDoneStatus(i.Data.Fields.Status) {
 		sti = "✅"
 	}
-	lbl := "None"
-	if len(i.Data.Fields.Labels) > 0 {
-		lbl = strings.Join(i.Data.Fields.Labels, ", ")
-	}
-	components := make([]string, 0, len(i.Data.Fields.Components))
-	for _, c := range i.Data.Fields.Components {
-		components = append(components, c.Name)
-	}
-	cmpt := "None"
-	if len(components) > 0 {
-		cmpt = strings.Join(components, ", ")
-	}
-	it, iti := i.Data.Fields.IssueType.Name, "⭐"
-	if it == "Bug" {
-		iti = "🐞"
+	if res := i.Data.Fields.Resolution.Name; res != "" {
+		st = fmt.Sprintf("%s (%s)", st, res)
 	}
+	lbl := joinTruncated(i.Data.Fields.Labels, getHeaderMaxItems())
+	cmpt := joinTruncated(i.componentNames(), getHeaderMaxItems())
+	it := i.Data.Fields.IssueType.Name
+	iti, _ := cmdutil.IssueTypeIcon(it)
 	wch := fmt.Sprintf("%d watchers", i.Data.Fields.Watches.WatchCount)
 	if i.Data.Fields.Watches.WatchCount == 1 && i.Data.Fields.Watches.IsWatching {
 		wch = "You are watching"
 	} else if i.Data.Fields.Watches.IsWatching {
 		wch = fmt.Sprintf("You + %d watchers", i.Data.Fields.Watches.WatchCount-1)
 	}
+	parent := ""
+	if i.Data.Fields.Parent != nil {
+		parent = fmt.Sprintf("  ⬆️  %s", i.Data.Fields.Parent.Key)
+	}
 	return fmt.Sprintf(
-		"%s %s  %s %s  ⌛ %s  👷 %s  🔑️ %s  💭 %d comments  \U0001F9F5 %d linked\n# %s\n⏱️  %s  🔎 %s  🚀 %s  📦 %s  🏷️  %s  👀 %s",
-		iti, it, sti, st, cmdutil.FormatDateTimeHuman(i.Data.Fields.Updated, jira.RFC3339), as, i.Data.Key,
+		"%s %s  %s %s  ⌛ %s  👷 %s  🔑️ %s%s  💭 %d comments  \U0001F9F5 %d linked\n# %s\n⏱️  %s  🔎 %s  🚀 %s  📦 %s  🏷️  %s  👀 %s",
+		iti, it, sti, st, cmdutil.FormatDateTimeHuman(i.Data.Fields.Updated, jira.RFC3339), as, i.Data.Key, parent,
 		i.Data.Fields.Comment.Total, len(i.Data.Fields.IssueLinks),
 		i.Data.Fields.Summary,
 		cmdutil.FormatDateTimeHuman(i.Data.Fields.Created, jira.RFC3339), i.Data.Fields.Reporter.Name,
@@ -224,6 +281,50 @@ func (i *IssueModel) header() string {
 	)
 }
 
+func (i *IssueModel) componentNames() []string {
+	components := make([]string, 0, len(i.Data.Fields.Components))
+	for _, c := range i.Data.Fields.Components {
+		components = append(components, c.Name)
+	}
+	return components
+}
+
+// getHeaderMaxItems reads `ui.issue.header_max_items`, the number of labels and
+// components listed in the header before the rest is cut, 0 lists all of them.
+func getHeaderMaxItems() int {
+	if !viper.IsSet("ui.issue.header_max_items") {
+		return 5
+	}
+	return max(viper.GetInt("ui.issue.header_max_items"), 0)
+}
+
+// joinTruncated joins the items with commas, items past limit are replaced
+// with a "+N more" suffix.
+func joinTruncated(items []string, limit int) string {
+	if len(items) == 0 {
+		return "None"
+	}
+	if limit == 0 || len(items) <= limit {
+		return strings.Join(items, ", ")
+	}
+	return fmt.Sprintf("%s +%d more", strings.Join(items[:limit], ", "), len(items)-limit)
+}
+
+// metadataTruncated tells if the header doesn't list all labels or components.
+func (i *IssueModel) metadataTruncated() bool {
+	limit := getHeaderMaxItems()
+	return limit > 0 && (len(i.Data.Fields.Labels) > limit || len(i.Data.Fields.Components) > limit)
+}
+
+// metadata lists all labels and components, the markdown renderer wraps them
+// to the pane width.
+func (i *IssueModel) metadata() string {
+	return fmt.Sprintf(
+		"**Labels:** %s\n\n**Components:** %s",
+		joinTruncated(i.Data.Fields.Labels, 0), joinTruncated(i.componentNames(), 0),
+	)
+}
+
 func (i *IssueModel) description() string {
 	if i.Data.Fields.Description == nil {
 		return ""
@@ -232,7 +333,7 @@ func (i *IssueModel) description() string {
 	var desc string
 
 	if adfNode, ok := i.Data.Fields.Description.(*adf.ADFNode); ok {
-		desc = adf2md.NewTranslator(adf2md.NewMarkdownTranslator()).Translate(adfNode)
+		desc = i.adfTranslator().Translate(adfNode)
 	} else {
 		desc = i.Data.Fields.Description.(string)
 		desc = md.FromJiraMD(desc)
@@ -245,6 +346,107 @@ func (i *IssueModel) description() string {
 	return desc
 }
 
+// adfTranslator returns a view-only ADF to markdown translator, which renders
+// media nodes as links to the matching attachments.
+func (i *IssueModel) adfTranslator() *adf2md.Translator {
+	openHooks := editing.BlockOpenHooks()
+	openHooks[adf.NodeMedia] = i.mediaLink
+	openHooks[adf.InlineNodeMention] = mention
+
+	return adf2md.NewTranslator(adf2md.NewMarkdownTranslator(
+		adf2md.WithMarkdownOpenHooks(openHooks),
+		adf2md.WithMarkdownCloseHooks(editing.BlockCloseHooks()),
+	))
+}
+
+// mention renders a mention node with the display name of the user, e.g.
+// @John Doe, instead of the account ID. The email resolver of the translator
+// isn't used, as it logs to stderr when a user can't be resolved, which would
+// break the TUI. Names are only read from the cache, see resolveMentions.
+func mention(n adf2md.Connector) string {
+	attrs, _ := n.GetAttributes().(map[string]any)
+
+	if id, ok := attrs["id"].(string); ok && id != "" {
+		if name, _ := editing.CachedDisplayName(id); name != "" {
+			return " @" + name
+		}
+	}
+	if text, ok := attrs["text"].(string); ok && text != "" {
+		return " @" + strings.TrimPrefix(text, "@")
+	}
+	return " @unknown"
+}
+
+// resolveMentions looks up the display names of the users mentioned in the
+// issue that aren't cached yet, the issue is rendered again once they are in.
+func resolveMentions(c *jira.Client, index int, iss *jira.Issue) tea.Cmd {
+	ids := unresolvedMentions(iss)
+	if len(ids) == 0 {
+		return nil
+	}
+	return func() tea.Msg {
+		for _, id := range ids {
+			editing.ResolveUserIDToDisplayName(id, c)
+		}
+		return MentionsResolvedMsg{issueKey: iss.Key, index: index}
+	}
+}
+
+// unresolvedMentions returns the account IDs mentioned in the description and
+// the comments of the issue whose display names aren't cached.
+func unresolvedMentions(iss *jira.Issue) []string {
+	var ids []string
+	var walk func(n *adf.ADFNode)
+	walk = func(n *adf.ADFNode) {
+		if n == nil {
+			return
+		}
+		if n.Type == adf.InlineNodeMention {
+			if id, ok := n.Attrs["id"].(string); ok && id != "" && !slices.Contains(ids, id) {
+				if _, cached := editing.CachedDisplayName(id); !cached {
+					ids = append(ids, id)
+				}
+			}
+		}
+		for _, c := range n.Content {
+			walk(c)
+		}
+	}
+
+	if node, ok := iss.Fields.Description.(*adf.ADFNode); ok {
+		walk(node)
+	}
+	for _, c := range iss.Fields.Comment.Comments {
+		if node, ok := c.Body.(*adf.ADFNode); ok {
+			walk(node)
+		}
+	}
+	return ids
+}
+
+// mediaLink renders a media node as a reference link, e.g. [📎 screenshot.png](url).
+// Media nodes only carry a media ID, so the attachment is matched by its file name.
+// If no attachment matches, the link points to the issue in the browser instead.
+func (i *IssueModel) mediaLink(n adf2md.Connector) string {
+	var attrs adf2md.MediaAttributes
+	if b, err := json.Marshal(n.GetAttributes()); err == nil {
+		_ = json.Unmarshal(b, &attrs)
+	}
+
+	name, url := attrs.Alt, fmt.Sprintf("%s/browse/%s", i.Server, i.Data.Key)
+	for _, a := range i.Data.Fields.Attachments {
+		if a.ID == attrs.ID || (attrs.Alt != "" && a.Filename == attrs.Alt) {
+			name, url = a.Filename, a.Content
+			break
+		}
+	}
+	if name == "" {
+		name = "attachment"
+	}
+
+	return fmt.Sprintf("\n[📎 %s](%s)\n", name, url)
+}
+
 func (i *IssueModel) colorizeSelected(input string) string {
 	re := regexp.MustCompile(`\[(.*?)\]\((.*?)\)`)
 	matches := re.FindAllStringSubmatchIndex(input, -1)
@@ -321,7 +523,7 @@ func (i *IssueModel) subtasks() string {
 
 	var (
 		subtasks       strings.Builder
-		summaryLen     = defaultSummaryLength
+		summaryLen     = detailSummaryLength()
 		maxKeyLen      int
 		maxSummaryLen  int
 		maxStatusLen   int
@@ -369,7 +571,7 @@ func (i *IssueModel) linkedIssues() string {
 		linked         strings.Builder
 		keys           = make([]string, 0)
 		linkMap        = make(map[string][]*jira.Issue, len(i.Data.Fields.IssueLinks))
-		summaryLen     = defaultSummaryLength
+		summaryLen     = detailSummaryLength()
 		maxKeyLen      int
 		maxSummaryLen  int
 		maxTypeLen     int
@@ -377,6 +579,15 @@ func (i *IssueModel) linkedIssues() string {
 		maxPriorityLen int
 	)
 
+	// The parent and the subtasks are shown already
+	shown := make(map[string]bool, len(i.Data.Fields.Subtasks)+1)
+	if i.Data.Fields.Parent != nil {
+		shown[i.Data.Fields.Parent.Key] = true
+	}
+	for _, task := range i.Data.Fields.Subtasks {
+		shown[task.Key] = true
+	}
+
 	for _, link := range i.Data.Fields.IssueLinks {
 		var (
 			linkType    string
@@ -391,7 +602,7 @@ func (i *IssueModel) linkedIssues() string {
 			linkedIssue = link.OutwardIssue
 		}
 
-		if linkedIssue == nil {
+		if linkedIssue == nil || shown[linkedIssue.Key] {
 			continue
 		}
 
@@ -399,34 +610,66 @@ func (i *IssueModel) linkedIssues() string {
 			keys = append(keys, linkType)
 		}
 		linkMap[linkType] = append(linkMap[linkType], linkedIssue)
+	}
+
+	// We are sorting keys to respect the order we see in the UI.
+	sort.Strings(keys)
 
-		maxKeyLen = max(len(linkedIssue.Key), maxKeyLen)
-		maxSummaryLen = max(len(linkedIssue.Fields.Summary), maxSummaryLen)
-		maxTypeLen = max(len(linkedIssue.Fields.IssueType.Name), maxTypeLen)
-		maxStatusLen = max(len(linkedIssue.Fields.Status.Name), maxStatusLen)
-		maxPriorityLen = max(len(linkedIssue.Fields.Priority.Name), maxPriorityLen)
+	// An issue linked more than once is listed under the first link type only,
+	// along with the other ones
+	var (
+		groups []string
+		also   = make(map[string][]string)
+	)
+	for _, k := range keys {
+		issues := make([]*jira.Issue, 0, len(linkMap[k]))
+		for _, iss := range linkMap[k] {
+			if _, ok := also[iss.Key]; ok {
+				if !slices.Contains(also[iss.Key], k) {
+					also[iss.Key] = append(also[iss.Key], k)
+				}
+				continue
+			}
+			also[iss.Key] = []string{}
+			issues = append(issues, iss)
+
+			maxKeyLen = max(len(iss.Key), maxKeyLen)
+			maxSummaryLen = max(len(iss.Fields.Summary), maxSummaryLen)
+			maxTypeLen = max(len(iss.Fields.IssueType.Name), maxTypeLen)
+			maxStatusLen = max(len(iss.Fields.Status.Name), maxStatusLen)
+			maxPriorityLen = max(len(iss.Fields.Priority.Name), maxPriorityLen)
+		}
+		if len(issues) > 0 {
+			linkMap[k] = issues
+			groups = append(groups, k)
+		}
 	}
 
 	if maxSummaryLen < summaryLen {
 		summaryLen = maxSummaryLen
 	}
 
-	// We are sorting keys to respect the order we see in the UI.
-	sort.Strings(keys)
-
-	for _, k := range keys {
+	for _, k := range groups {
 		linked.WriteString(
 			fmt.Sprintf("\n %s\n\n", coloredOut(strings.ToUpper(k), color.FgWhite, color.Bold)),
 		)
 		for _, iss := range linkMap[k] {
+			var note string
+			if iss.Key == i.Data.Key {
+				note = " • " + coloredOut("this issue", color.FgYellow, color.Bold)
+			}
+			if others := also[iss.Key]; len(others) > 0 {
+				note += " • " + gray("also "+strings.Join(others, ", "))
+			}
 			linked.WriteString(
 				fmt.Sprintf(
-					"  %s %s • %s • %s • %s\n",
+					"  %s %s • %s • %s • %s%s\n",
 					coloredOut(pad(iss.Key, maxKeyLen), color.FgGreen, color.Bold),
 					shortenAndPad(iss.Fields.Summary, summaryLen),
 					pad(iss.Fields.IssueType.Name, maxTypeLen),
 					pad(iss.Fields.Priority.Name, maxPriorityLen),
 					pad(iss.Fields.Status.Name, maxStatusLen),
+					note,
 				),
 			)
 		}
@@ -452,7 +695,7 @@ func (i *IssueModel) comments() []issueComment {
 		c := i.Data.Fields.Comment.Comments[idx]
 		var body string
 		if adfNode, ok := c.Body.(*adf.ADFNode); ok {
-			body = adf2md.NewTranslator(adf2md.NewMarkdownTranslator()).Translate(adfNode)
+			body = i.adfTranslator().Translate(adfNode)
 		} else {
 			body = c.Body.(string)
 			body = md.FromJiraMD(body)
@@ -462,6 +705,9 @@ func (i *IssueModel) comments() []issueComment {
 		body = i.colorizeSelected(body)
 
 		authorName := c.Author.GetDisplayableName()
+		if i.commentAuthor != "" && authorName != i.commentAuthor {
+			continue
+		}
 
 		meta := fmt.Sprintf(
 			"\n %s • %s",
@@ -471,15 +717,152 @@ func (i *IssueModel) comments() []issueComment {
 		if idx == total-1 {
 			meta += fmt.Sprintf(" • %s", coloredOut("Latest comment", color.FgCyan, color.Bold))
 		}
+		if c.IsInternal() {
+			meta += fmt.Sprintf(" • %s", coloredOut("[internal]", color.FgYellow, color.Bold))
+		}
+		if isNewComment(c.Created, i.lastViewed) {
+			meta += fmt.Sprintf(" • %s", coloredOut("● new", color.FgGreen, color.Bold))
+		}
 		comments = append(comments, issueComment{
 			meta: meta,
 			body: body,
 		})
 	}
 
+	if cmdutil.CommentsOldestFirst() {
+		slices.Reverse(comments)
+	}
 	return comments
 }
 
+// getSinceLastViewed reads `ui.issue.since_last_viewed`, set by `jira ui
+// --since-last-viewed`, if set the comments added since the issue was last
+// opened are marked as new.
+func getSinceLastViewed() bool {
+	return viper.GetBool("ui.issue.since_last_viewed")
+}
+
+// loadViewed reads when the issues were last opened, if new comments are marked.
+// The history is read once when the UI starts, so an issue opened with enter
+// keeps its marks for the rest of the session. Only enter and `jira issue
+// view` count as opening an issue, showing it in the detail pane doesn't.
+func loadViewed() map[string]time.Time {
+	if !getSinceLastViewed() {
+		return nil
+	}
+	viewed, _ := history.Viewed()
+	return viewed
+}
+
+// isNewComment tells if the comment was created after the issue was last viewed.
+func isNewComment(created string, lastViewed time.Time) bool {
+	if lastViewed.IsZero() {
+		return false
+	}
+	t, err := time.Parse(jira.RFC3339, created)
+	return err == nil && t.After(lastViewed)
+}
+
+// getCollapsedComments reads `ui.issue.collapsed_comments`, the number of latest
+// comments shown before the rest is expanded, 0 shows all comments.
+func getCollapsedComments() int {
+	if !viper.IsSet("ui.issue.collapsed_comments") {
+		return 3
+	}
+	return max(viper.GetInt("ui.issue.collapsed_comments"), 0)
+}
+
+// visibleComments returns the comments to render in the configured order and
+// the number of earlier comments collapsed.
+func (i *IssueModel) visibleComments() ([]issueComment, int) {
+	comments := i.comments()
+
+	// the comments of a single author are few enough to be shown in full
+	shown := getCollapsedComments()
+	if i.commentsExpanded || i.commentAuthor != "" || shown == 0 || len(comments) <= shown {
+		return comments, 0
+	}
+	hidden := len(comments) - shown
+	if cmdutil.CommentsOldestFirst() {
+		return comments[hidden:], hidden
+	}
+	return comments[:shown], hidden
+}
+
+// toggleComments expands or collapses earlier comments, keeping the scroll
+// position where possible.
+func (iss *IssueModel) toggleComments() {
+	iss.commentsExpanded = !iss.commentsExpanded
+	iss.rerender()
+}
+
+// commentAuthorItem is a list item of the comment author selector, the empty
+// author stands for all of them.
+type commentAuthorItem struct {
+	name  string
+	count int
+}
+
+func (a commentAuthorItem) Title() string {
+	if a.name == "" {
+		return "All authors"
+	}
+	return a.name
+}
+
+func (a commentAuthorItem) Description() string {
+	if a.name == "" {
+		return "Show every comment"
+	}
+	if a.count == 1 {
+		return "1 comment"
+	}
+	return fmt.Sprintf("%d comments", a.count)
+}
+
+func (a commentAuthorItem) FilterValue() string { return a.name }
+
+// commentAuthors lists the distinct authors of the loaded comments by name,
+// preceded by the item clearing the filter.
+func (i *IssueModel) commentAuthors() []commentAuthorItem {
+	counts := make(map[string]int)
+	for _, c := range i.Data.Fields.Comment.Comments {
+		counts[c.Author.GetDisplayableName()]++
+	}
+
+	authors := make([]commentAuthorItem, 0, len(counts))
+	for name, count := range counts {
+		authors = append(authors, commentAuthorItem{name: name, count: count})
+	}
+	sort.Slice(authors, func(a, b int) bool { return authors[a].name < authors[b].name })
+
+	return append([]commentAuthorItem{{}}, authors...)
+}
+
+// filterCommentsByAuthor shows only the comments of the author, an empty
+// author shows all of them again.
+func (iss *IssueModel) filterCommentsByAuthor(author string) {
+	iss.commentAuthor = author
+	iss.rerender()
+}
+
+// toggleMetadata expands or collapses the full list of labels and components.
+func (iss *IssueModel) toggleMetadata() {
+	if !iss.metadataTruncated() {
+		return
+	}
+	iss.metadataExpanded = !iss.metadataExpanded
+	iss.rerender()
+}
+
+// rerender renders the issue again, keeping the scroll position where possible.
+func (iss *IssueModel) rerender() {
+	firstVisibleLine := iss.firstVisibleLine
+	iss.ResetResetables()
+	iss.prepareRenderedLines()
+	iss.firstVisibleLine = min(firstVisibleLine, max(len(iss.renderedLines)-iss.contentHeight, 0))
+}
+
 func (i *IssueModel) footer() string {
 	var out strings.Builder
 
@@ -504,6 +887,10 @@ func (iss IssueModel) Update(msg tea.Msg) (IssueModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case *jira.Issue:
 		iss.Data = msg
+		iss.commentsExpanded = false
+		iss.metadataExpanded = false
+		iss.commentAuthor = ""
+		iss.lastViewed = iss.viewed[msg.Key]
 		// Reset scroll when new issue is loaded
 		iss.ResetResetables()
 	case WidgetSizeMsg:
@@ -512,6 +899,13 @@ func (iss IssueModel) Update(msg tea.Msg) (IssueModel, tea.Cmd) {
 		iss.calculateViewportDimensions()
 		// Reset rendered lines when size changes
 		iss.renderedLines = nil
+	case tea.MouseWheelMsg:
+		switch msg.Button {
+		case tea.MouseWheelDown:
+			iss.scrollDown()
+		case tea.MouseWheelUp:
+			iss.scrollUp()
+		}
 	case tea.KeyMsg:
 		switch msg.String() {
 		case "ctrl+e":
@@ -519,29 +913,16 @@ func (iss IssueModel) Update(msg tea.Msg) (IssueModel, tea.Cmd) {
 		case "ctrl+y":
 			iss.scrollUp()
 		case "tab":
-			if iss.currentlyHighlightedLinkPos == iss.nLinks-1 {
-				// set to "no links selected"
-				iss.currentlyHighlightedLinkPos = -1
-				// scroll back up all the way
-				iss.firstVisibleLine = 0
-			} else {
-				iss.currentlyHighlightedLinkPos++
-				// scroll down until the link is visible
-				for {
-					iss.prepareRenderedLines()
-					out := iss.getVisibleLines()
-
-					if len(iss.uniqueLinkTitleReplacement) > 0 && strings.Contains(out, iss.uniqueLinkTitleReplacement) {
-						break
-					}
-
-					visibleNow := iss.firstVisibleLine
-					iss.scrollDown()
-					visibleAfterScroll := iss.firstVisibleLine
-					if visibleNow == visibleAfterScroll {
-						iss.firstVisibleLine = 0
-					}
-				}
+			iss.cycleLink(+1)
+		case "shift+tab":
+			iss.cycleLink(-1)
+		case "C":
+			if iss.Data != nil {
+				iss.toggleComments()
+			}
+		case "L":
+			if iss.Data != nil {
+				iss.toggleMetadata()
 			}
 		}
 	}
@@ -554,6 +935,65 @@ func (iss IssueModel) Update(msg tea.Msg) (IssueModel, tea.Cmd) {
 	return iss, cmd
 }
 
+// cycleLink moves the link selection by delta, wrapping around. Position -1
+// ("no link selected") is a part of the cycle, so that the selection can be cleared.
+func (iss *IssueModel) cycleLink(delta int) {
+	if iss.nLinks == 0 {
+		return
+	}
+
+	states := iss.nLinks + 1
+	pos := (iss.currentlyHighlightedLinkPos + 1 + delta + states) % states
+	iss.selectLink(pos - 1)
+}
+
+// selectLink highlights the link at pos and scrolls to it, -1 clears the selection.
+func (iss *IssueModel) selectLink(pos int) {
+	iss.currentlyHighlightedLinkPos = pos
+	iss.uniqueLinkTitleReplacement = ""
+	iss.uniqueLinkTextReplacement = ""
+	iss.prepareRenderedLines()
+
+	if pos == -1 {
+		iss.firstVisibleLine = 0
+		return
+	}
+
+	if line := iss.highlightedLinkLine(); line != -1 {
+		iss.scrollToLine(line)
+	}
+}
+
+// highlightedLinkLine returns the index in renderedLines where the highlighted link
+// starts, or -1 if it was not rendered.
+func (iss *IssueModel) highlightedLinkLine() int {
+	for _, marker := range []string{iss.uniqueLinkTitleReplacement, iss.uniqueLinkTextReplacement} {
+		if marker == "" {
+			continue
+		}
+		for n, line := range iss.renderedLines {
+			if strings.Contains(line, marker) {
+				return n
+			}
+		}
+	}
+	return -1
+}
+
+// scrollToLine makes sure the line is visible, placing it on top when it is out of the viewport.
+func (iss *IssueModel) scrollToLine(line int) {
+	if line >= iss.firstVisibleLine && line < iss.firstVisibleLine+iss.contentHeight {
+		return
+	}
+
+	maxScroll := len(iss.renderedLines) - iss.contentHeight
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+
+	iss.firstVisibleLine = min(line, maxScroll)
+}
+
 func (iss *IssueModel) calculateViewportDimensions() {
 	// Calculate viewport with 10% margins
 	iss.viewportWidth = int(float32(iss.RawWidth) * 0.9)
@@ -614,16 +1054,47 @@ func (iss *IssueModel) prepareRenderedLines() {
 		renderWidth = 40
 	}
 
-	r, err := MDRendererWithWidth(getCurrentTheme(), renderWidth)
+	iss.renderWidth = renderWidth
+
+	out, err := iss.renderOut()
 	if err != nil {
-		cmdutil.ExitIfError(fmt.Errorf("Failed to create an MDRenderer: %w", err))
+		// The issue is still readable without styles
+		debug.Debug("failed to render", iss.Data.Key, "falling back to raw markdown:", err)
+		out = iss.rawOut()
 	}
-	out, err := iss.RenderedOut(r)
+
+	iss.renderedLines = strings.Split(out, "\n")
+}
+
+// newMDRenderer creates the renderer of the detail view, it is stubbed in tests.
+var newMDRenderer = MDRendererWithWidth
+
+// renderOut renders the issue with glamour. A panic of glamour on pathological
+// input is turned into an error as well.
+func (iss *IssueModel) renderOut() (out string, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("markdown renderer panicked: %v", p)
+		}
+	}()
+
+	r, err := newMDRenderer(getCurrentTheme(), iss.renderWidth)
 	if err != nil {
-		cmdutil.ExitIfError(fmt.Errorf("Failed to render an issue using MDRenderer: %w", err))
+		return "", fmt.Errorf("failed to create an MDRenderer: %w", err)
 	}
+	return iss.RenderedOut(r)
+}
 
-	iss.renderedLines = strings.Split(out, "\n")
+// rawOut is the issue as unstyled markdown, shown when it can't be rendered.
+func (iss *IssueModel) rawOut() string {
+	var res strings.Builder
+	for _, p := range iss.fragments() {
+		res.WriteString(p.Body)
+		if p.Parse && !strings.HasSuffix(p.Body, "\n") {
+			res.WriteString("\n")
+		}
+	}
+	return res.String()
 }
 
 func NewIssueModel(server string) IssueModel {
@@ -636,6 +1107,7 @@ func NewIssueModel(server string) IssueModel {
 		Server:                            server,
 		Data:                              nil,
 		Options:                           IssueOption{NumComments: 10},
+		linkKeys:                          getOSC8Links(),
 		currentlyHighlightedLinkPos:       -1,
 		currentlyHighlightedLinkCountdown: -1,
 		spinner:                           s,
@@ -706,6 +1178,10 @@ func (iss IssueModel) View() string {
 
 	out := iss.getVisibleLines()
 
+	if iss.linkKeys {
+		out = linkIssueKeys(out, iss.Server, iss.issueKeys())
+	}
+
 	if len(iss.uniqueLinkTitleReplacement) > 0 && strings.Contains(out, iss.uniqueLinkTitleReplacement) {
 		coloredText := coloredOut(iss.currentlyHighlightedLinkText, color.BgYellow)
 		out = strings.ReplaceAll(out, iss.uniqueLinkTitleReplacement, coloredText)
@@ -0,0 +1,263 @@
+package bubble
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/md2adf-translator/adf"
+
+	"github.com/jorres/jira-tui/internal/history"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// commentsFixture returns an issue with n comments, "comment 1" being the oldest.
+func commentsFixture(n int) *jira.Issue {
+	iss := &jira.Issue{Key: "TEST-1"}
+	for i := 1; i <= n; i++ {
+		iss.Fields.Comment.Comments = append(iss.Fields.Comment.Comments, jira.Comment{
+			ID:   fmt.Sprint(i),
+			Body: fmt.Sprintf("comment %d", i),
+		})
+	}
+	iss.Fields.Comment.Total = n
+	return iss
+}
+
+func TestVisibleCommentsCollapsed(t *testing.T) {
+	iss := commentsFixture(5)
+
+	m := NewIssueModel("https://jira.example.com")
+	m.Data = iss
+
+	comments, hidden := m.visibleComments()
+	assert.Len(t, comments, 3)
+	assert.Equal(t, 2, hidden)
+	assert.Contains(t, comments[0].body, "comment 5")
+
+	m.commentsExpanded = true
+	comments, hidden = m.visibleComments()
+	assert.Len(t, comments, 5)
+	assert.Zero(t, hidden)
+
+	m.commentsExpanded = false
+	viper.Set("ui.issue.collapsed_comments", 0)
+	t.Cleanup(func() { viper.Set("ui.issue.collapsed_comments", nil) })
+
+	comments, hidden = m.visibleComments()
+	assert.Len(t, comments, 5)
+	assert.Zero(t, hidden)
+}
+
+func TestVisibleCommentsOldestFirst(t *testing.T) {
+	iss := commentsFixture(5)
+
+	viper.Set("ui.issue.comment_order", "asc")
+	t.Cleanup(func() { viper.Set("ui.issue.comment_order", nil) })
+
+	m := NewIssueModel("https://jira.example.com")
+	m.Data = iss
+	m.Options.NumComments = 4
+
+	comments, hidden := m.visibleComments()
+	assert.Equal(t, 1, hidden)
+	assert.Len(t, comments, 3)
+	assert.Contains(t, comments[0].body, "comment 3")
+	assert.Contains(t, comments[2].body, "comment 5")
+	assert.Contains(t, comments[2].meta, "Latest comment")
+	assert.NotContains(t, comments[0].meta, "Latest comment")
+
+	m.commentsExpanded = true
+	comments, _ = m.visibleComments()
+	assert.Len(t, comments, 4)
+	assert.Contains(t, comments[0].body, "comment 2")
+}
+
+func TestHeaderTruncatesMetadata(t *testing.T) {
+	iss := &jira.Issue{Key: "TEST-1"}
+	for n := 1; n <= 8; n++ {
+		iss.Fields.Labels = append(iss.Fields.Labels, fmt.Sprintf("label-%d", n))
+	}
+	iss.Fields.Components = []struct {
+		Name string `json:"name"`
+	}{{Name: "api"}}
+
+	m := NewIssueModel("https://jira.example.com")
+	m.Data = iss
+
+	header := m.header()
+	assert.Contains(t, header, "label-5 +3 more")
+	assert.NotContains(t, header, "label-6")
+	assert.Contains(t, header, "📦 api")
+	assert.True(t, m.metadataTruncated())
+
+	m.metadataExpanded = true
+	assert.Contains(t, m.metadata(), "label-1, label-2, label-3, label-4, label-5, label-6, label-7, label-8")
+
+	viper.Set("ui.issue.header_max_items", 0)
+	t.Cleanup(func() { viper.Set("ui.issue.header_max_items", nil) })
+
+	assert.Contains(t, m.header(), "label-8")
+	assert.False(t, m.metadataTruncated())
+}
+
+func TestJoinTruncated(t *testing.T) {
+	assert.Equal(t, "None", joinTruncated(nil, 5))
+	assert.Equal(t, "a, b", joinTruncated([]string{"a", "b"}, 2))
+	assert.Equal(t, "a +2 more", joinTruncated([]string{"a", "b", "c"}, 1))
+	assert.Equal(t, "a, b, c", joinTruncated([]string{"a", "b", "c"}, 0))
+}
+
+func TestMentionFallsBackToText(t *testing.T) {
+	assert.Equal(t, " @John Doe", mention(&adf.ADFNode{
+		Type:  adf.InlineNodeMention,
+		Attrs: map[string]any{"text": "@John Doe"},
+	}))
+	assert.Equal(t, " @unknown", mention(&adf.ADFNode{Type: adf.InlineNodeMention}))
+}
+
+func TestCommentsFilteredByAuthor(t *testing.T) {
+	iss := &jira.Issue{Key: "TEST-1"}
+	for n, author := range []string{"QA Lead", "Dev", "QA Lead", "Dev", "Dev"} {
+		iss.Fields.Comment.Comments = append(iss.Fields.Comment.Comments, jira.Comment{
+			ID:     fmt.Sprint(n + 1),
+			Author: jira.User{DisplayName: author},
+			Body:   fmt.Sprintf("comment %d", n+1),
+		})
+	}
+	iss.Fields.Comment.Total = 5
+
+	m := NewIssueModel("https://jira.example.com")
+	m.Data = iss
+
+	assert.Equal(t, []commentAuthorItem{{}, {name: "Dev", count: 3}, {name: "QA Lead", count: 2}}, m.commentAuthors())
+
+	m.commentAuthor = "QA Lead"
+	comments, hidden := m.visibleComments()
+	assert.Len(t, comments, 2)
+	assert.Zero(t, hidden)
+	assert.Contains(t, comments[0].body, "comment 3")
+	assert.Contains(t, comments[1].body, "comment 1")
+}
+
+func TestCommentsSinceLastViewed(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Cleanup(viper.Reset)
+
+	iss := &jira.Issue{Key: "TEST-1"}
+	for n, created := range []string{"2025-05-01T10:00:00.000+0000", "2025-05-03T10:00:00.000+0000"} {
+		iss.Fields.Comment.Comments = append(iss.Fields.Comment.Comments, jira.Comment{
+			ID:      fmt.Sprint(n + 1),
+			Body:    fmt.Sprintf("comment %d", n+1),
+			Created: created,
+		})
+	}
+	iss.Fields.Comment.Total = 2
+
+	m := NewIssueModel("https://jira.example.com")
+	m, _ = m.Update(iss)
+	assert.True(t, m.lastViewed.IsZero())
+
+	m.lastViewed = time.Date(2025, 5, 2, 0, 0, 0, 0, time.UTC)
+	comments := m.comments()
+	assert.Contains(t, comments[0].meta, "● new")
+	assert.NotContains(t, comments[1].meta, "● new")
+
+	// Issues opened before are only looked up when enabled
+	assert.NoError(t, history.Add("TEST-1"))
+	assert.Nil(t, loadViewed())
+
+	viper.Set("ui.issue.since_last_viewed", true)
+	m.viewed = loadViewed()
+	m, _ = m.Update(iss)
+	assert.False(t, m.lastViewed.IsZero())
+	for _, c := range m.comments() {
+		assert.NotContains(t, c.meta, "● new")
+	}
+}
+
+func TestRenderFallsBackToRawMarkdown(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	newMDRenderer = func(string, int) (*glamour.TermRenderer, error) {
+		return nil, errors.New("broken renderer")
+	}
+	t.Cleanup(func() { newMDRenderer = MDRendererWithWidth })
+
+	iss := &jira.Issue{Key: "TEST-1"}
+	iss.Fields.Summary = "Renderer chokes"
+	iss.Fields.Description = "Still readable"
+
+	m := NewIssueModel("https://jira.example.com")
+	m.Data = iss
+	m.prepareRenderedLines()
+
+	out := strings.Join(m.renderedLines, "\n")
+	assert.Contains(t, out, "# Renderer chokes")
+	assert.Contains(t, out, "Still readable")
+}
+
+func TestLinkedIssuesDeduplicated(t *testing.T) {
+	var iss jira.Issue
+	err := json.Unmarshal([]byte(`{
+		"key": "TEST-1",
+		"fields": {
+			"parent": {"key": "TEST-100"},
+			"subtasks": [{"key": "TEST-2"}],
+			"issuelinks": [
+				{"type": {"outward": "relates to"}, "outwardIssue": {"key": "TEST-100"}},
+				{"type": {"outward": "blocks"}, "outwardIssue": {"key": "TEST-2"}}
+			]
+		}
+	}`), &iss)
+	assert.NoError(t, err)
+
+	m := &IssueModel{Data: &iss}
+	assert.Empty(t, m.linkedIssues())
+
+	err = json.Unmarshal([]byte(`{
+		"fields": {
+			"issuelinks": [
+				{"type": {"outward": "relates to"}, "outwardIssue": {"key": "TEST-3", "fields": {"summary": "Flaky login"}}},
+				{"type": {"inward": "is blocked by"}, "inwardIssue": {"key": "TEST-3", "fields": {"summary": "Flaky login"}}},
+				{"type": {"outward": "duplicates"}, "outwardIssue": {"key": "TEST-1", "fields": {"summary": "Login fails"}}}
+			]
+		}
+	}`), &iss)
+	assert.NoError(t, err)
+
+	out := stripANSI(m.linkedIssues())
+	assert.Equal(t, 1, strings.Count(out, "TEST-3"))
+	assert.Contains(t, out, "Flaky login • ")
+	assert.Contains(t, out, "also relates to")
+	assert.Contains(t, out, "this issue")
+	assert.NotContains(t, out, "TEST-100")
+	assert.NotContains(t, out, "TEST-2")
+	assert.Less(t, strings.Index(out, "DUPLICATES"), strings.Index(out, "IS BLOCKED BY"))
+	assert.NotContains(t, out, "RELATES TO")
+}
+
+func TestUnresolvedMentions(t *testing.T) {
+	mentionNode := func(id string) *adf.ADFNode {
+		return &adf.ADFNode{Type: adf.InlineNodeMention, Attrs: map[string]any{"id": id}}
+	}
+	paragraph := func(nodes ...*adf.ADFNode) *adf.ADFNode {
+		return &adf.ADFNode{Type: "doc", Content: []*adf.ADFNode{{Type: adf.NodeParagraph, Content: nodes}}}
+	}
+
+	iss := &jira.Issue{Key: "TEST-1"}
+	iss.Fields.Description = paragraph(mentionNode("557058:a"))
+	iss.Fields.Comment.Comments = []jira.Comment{
+		{Body: paragraph(mentionNode("557058:a"), mentionNode("557058:b"))},
+		{Body: "plain text of a v2 comment"},
+	}
+
+	assert.Equal(t, []string{"557058:a", "557058:b"}, unresolvedMentions(iss))
+	assert.Nil(t, resolveMentions(nil, 0, &jira.Issue{Key: "TEST-2"}))
+}
@@ -0,0 +1,64 @@
+package bubble
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/v2/list"
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// issueProject returns the key of the project the issue belongs to.
+func issueProject(iss *jira.Issue) string {
+	project, _, _ := strings.Cut(iss.Key, "-")
+	return project
+}
+
+func issueTypesToItems(types []*jira.IssueType, current jira.IssueType) []list.Item {
+	listItems := []list.Item{}
+	for _, it := range jira.SwitchableIssueTypes(types, current) {
+		listItems = append(listItems, it)
+	}
+	return listItems
+}
+
+// selectIssueType opens the selector of the types the issue can be changed to,
+// the types of each project are loaded in background the first time.
+func (l *IssueList) selectIssueType(iss *jira.Issue) (tea.Model, tea.Cmd) {
+	project := issueProject(iss)
+	current := iss.Fields.IssueType
+
+	types, ok := l.cachedIssueTypes[project]
+	if !ok {
+		fz := NewFuzzySelectorFrom(l, l.rawWidth, l.rawHeight, []list.Item{}, FuzzySelectorIssueType)
+		return fz, tea.Batch(fz.list.StartSpinner(), func() tea.Msg {
+			types, err := l.c.GetCreateMetaIssueTypes(project)
+			return IssueTypesMsg{project: project, current: current, types: types, err: err}
+		})
+	}
+
+	items := issueTypesToItems(types, current)
+	if len(items) == 0 {
+		return l, l.setStatusMessage(fmt.Sprintf("%s can't be changed to another type", iss.Key))
+	}
+	return NewFuzzySelectorFrom(l, l.rawWidth, l.rawHeight, items, FuzzySelectorIssueType), nil
+}
+
+func (l *IssueList) changeIssueType(iss *jira.Issue, issueType string) tea.Cmd {
+	return func() tea.Msg {
+		var err error
+		edr := &jira.EditRequest{IssueType: issueType}
+		if viper.GetString("installation") == jira.InstallationTypeLocal {
+			err = l.c.EditV2(iss.Key, edr)
+		} else {
+			err = l.c.Edit(iss.Key, edr)
+		}
+		if err != nil {
+			return IssueTypeChangedMsg{issueKey: iss.Key, err: err, stderr: err.Error()}
+		}
+		return IssueTypeChangedMsg{issueKey: iss.Key, issueType: issueType}
+	}
+}
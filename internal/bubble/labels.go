@@ -0,0 +1,173 @@
+package bubble
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// markPrefix is shown in front of the key of marked issues.
+const markPrefix = "● "
+
+// toggleMark marks the issue for a bulk action, or unmarks it.
+func (t *Table) toggleMark(key string) {
+	if t.marked == nil {
+		t.marked = make(map[string]bool)
+	}
+	if t.marked[key] {
+		delete(t.marked, key)
+	} else {
+		t.marked[key] = true
+	}
+}
+
+// markedKeys returns the keys of the marked issues shown in the table, in the table order.
+func (t *Table) markedKeys() []string {
+	var keys []string
+	for _, iss := range t.allIssues {
+		if t.marked[iss.Key] {
+			keys = append(keys, iss.Key)
+		}
+	}
+	return keys
+}
+
+// toggleMark marks or unmarks the issue under the cursor.
+func (l *IssueList) toggleMark() tea.Cmd {
+	table := l.getCurrentTable()
+	key := table.getKeyUnderCursorWithShift(0)
+	if key == "" {
+		return l.setStatusMessage("No issue selected")
+	}
+	table.toggleMark(key)
+
+	n := len(table.markedKeys())
+	if n == 0 {
+		return l.setStatusMessage("No issues marked")
+	}
+	return l.setStatusMessage(fmt.Sprintf("%d issue(s) marked, t to label them", n))
+}
+
+// labelPrompt asks for the label to add or remove on the terminal released
+// by the UI, it satisfies tea.ExecCommand.
+type labelPrompt struct {
+	count  int
+	known  []string
+	answer string
+}
+
+func (p *labelPrompt) Run() error {
+	return survey.AskOne(
+		&survey.Input{
+			Message: fmt.Sprintf("Label for %d issue(s), -label removes it", p.count),
+			Suggest: p.suggest,
+		},
+		&p.answer,
+		survey.WithValidator(survey.Required),
+	)
+}
+
+// suggest completes the input with the labels used by the issues loaded in the UI.
+func (p *labelPrompt) suggest(input string) []string {
+	prefix := ""
+	if strings.HasPrefix(input, "-") {
+		prefix, input = "-", input[1:]
+	}
+
+	var out []string
+	for _, lbl := range p.known {
+		if strings.HasPrefix(strings.ToLower(lbl), strings.ToLower(input)) {
+			out = append(out, prefix+lbl)
+		}
+	}
+	return out
+}
+
+func (p *labelPrompt) SetStdin(io.Reader)  {}
+func (p *labelPrompt) SetStdout(io.Writer) {}
+func (p *labelPrompt) SetStderr(io.Writer) {}
+
+// knownLabels lists the labels of the issues loaded in all tabs.
+func (l *IssueList) knownLabels() []string {
+	var labels []string
+	for _, t := range l.tables {
+		if t == nil {
+			continue
+		}
+		for _, iss := range t.allIssues {
+			labels = append(labels, iss.Fields.Labels...)
+		}
+	}
+	slices.Sort(labels)
+	return slices.Compact(labels)
+}
+
+// labelIssues prompts for a label to add to, or remove from, the marked
+// issues, or the issue under the cursor if none is marked.
+func (l *IssueList) labelIssues(iss *jira.Issue) tea.Cmd {
+	keys := l.getCurrentTable().markedKeys()
+	if len(keys) == 0 {
+		keys = []string{iss.Key}
+	}
+
+	p := &labelPrompt{count: len(keys), known: l.knownLabels()}
+	return tea.Exec(p, func(err error) tea.Msg {
+		return LabelEnteredMsg{issueKeys: keys, label: strings.TrimSpace(p.answer), err: err}
+	})
+}
+
+// applyLabel edits the issues one by one, a failure doesn't stop the others
+// from being labeled.
+func (l *IssueList) applyLabel(msg LabelEnteredMsg) tea.Cmd {
+	if errors.Is(msg.err, terminal.InterruptErr) || strings.TrimPrefix(msg.label, "-") == "" {
+		return nil
+	}
+	if msg.err != nil {
+		return func() tea.Msg {
+			return IssuesLabeledMsg{label: msg.label, err: msg.err}
+		}
+	}
+
+	index := l.activeTab
+	return func() tea.Msg {
+		out := IssuesLabeledMsg{index: index, label: msg.label}
+		for _, key := range msg.issueKeys {
+			var err error
+			edr := &jira.EditRequest{Labels: []string{msg.label}}
+			if viper.GetString("installation") == jira.InstallationTypeLocal {
+				err = l.c.EditV2(key, edr)
+			} else {
+				err = l.c.Edit(key, edr)
+			}
+			if err != nil {
+				out.failed = append(out.failed, fmt.Sprintf("%s: %s", key, err))
+				continue
+			}
+			out.issueKeys = append(out.issueKeys, key)
+		}
+		return out
+	}
+}
+
+// labeledStatus summarizes a bulk label change for the status bar.
+func labeledStatus(msg IssuesLabeledMsg) string {
+	verb, label := "Added", msg.label
+	if after, ok := strings.CutPrefix(label, "-"); ok {
+		verb, label = "Removed", after
+	}
+
+	status := fmt.Sprintf("%s label %q on %d issue(s)", verb, label, len(msg.issueKeys))
+	if len(msg.failed) > 0 {
+		status += fmt.Sprintf(", %d failed: %s", len(msg.failed), strings.Join(msg.failed, "; "))
+	}
+	return status
+}
@@ -0,0 +1,139 @@
+package bubble
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/charmbracelet/lipgloss/v2/table"
+)
+
+// tableIndent matches the left margin glamour puts in front of every block.
+const tableIndent = "  "
+
+var tableDelimiterRow = regexp.MustCompile(`^\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?$`)
+
+// renderMarkdown renders body with glamour, except for tables. Glamour sizes
+// table columns by their content and clips whatever doesn't fit into the word
+// wrap width, so tables are laid out with lipgloss instead, wrapping the cells
+// to fit into width.
+func renderMarkdown(renderer *glamour.TermRenderer, body string, width int) (string, error) {
+	var (
+		res   strings.Builder
+		chunk []string
+	)
+
+	flush := func() error {
+		if len(chunk) == 0 {
+			return nil
+		}
+		out, err := renderer.Render(strings.Join(chunk, "\n"))
+		if err != nil {
+			return err
+		}
+		res.WriteString(out)
+		chunk = nil
+		return nil
+	}
+
+	lines := strings.Split(body, "\n")
+	inCode := false
+	for n := 0; n < len(lines); n++ {
+		line := lines[n]
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCode = !inCode
+		}
+		if inCode || !isTableStart(lines, n) {
+			chunk = append(chunk, line)
+			continue
+		}
+
+		end := n + 2
+		for end < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[end]), "|") {
+			end++
+		}
+
+		if err := flush(); err != nil {
+			return "", err
+		}
+		res.WriteString(renderTable(lines[n:end], width))
+		res.WriteString("\n")
+
+		n = end - 1
+	}
+
+	if err := flush(); err != nil {
+		return "", err
+	}
+
+	return res.String(), nil
+}
+
+// isTableStart reports whether lines[n] is a table header, i.e. a pipe row
+// followed by a delimiter row like |---|:--:|.
+func isTableStart(lines []string, n int) bool {
+	if n+1 >= len(lines) {
+		return false
+	}
+	head, delim := strings.TrimSpace(lines[n]), strings.TrimSpace(lines[n+1])
+	return strings.HasPrefix(head, "|") && strings.Contains(delim, "-") && tableDelimiterRow.MatchString(delim)
+}
+
+// renderTable lays out markdown table rows, header and delimiter included,
+// into at most width columns of the terminal.
+func renderTable(rows []string, width int) string {
+	pale := lipgloss.NewStyle().Foreground(lipgloss.Color(getPaleColor()))
+	cell := lipgloss.NewStyle().Padding(0, 1)
+
+	t := table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(pale).
+		Width(max(width-len(tableIndent), 0)).
+		Wrap(true).
+		StyleFunc(func(row, _ int) lipgloss.Style {
+			if row == table.HeaderRow {
+				return cell.Bold(true)
+			}
+			return cell
+		}).
+		Headers(splitTableRow(rows[0])...)
+
+	for _, r := range rows[2:] {
+		t.Row(splitTableRow(r)...)
+	}
+
+	out := strings.Split(t.Render(), "\n")
+	for i := range out {
+		out[i] = tableIndent + out[i]
+	}
+	return strings.Join(out, "\n") + "\n"
+}
+
+// splitTableRow splits a markdown table row into trimmed cells, honouring
+// escaped pipes.
+func splitTableRow(row string) []string {
+	row = strings.TrimSpace(row)
+	row = strings.TrimPrefix(row, "|")
+	if strings.HasSuffix(row, "|") && !strings.HasSuffix(row, `\|`) {
+		row = strings.TrimSuffix(row, "|")
+	}
+
+	var (
+		cells []string
+		cur   strings.Builder
+	)
+	for i := 0; i < len(row); i++ {
+		switch {
+		case row[i] == '\\' && i+1 < len(row) && row[i+1] == '|':
+			cur.WriteByte('|')
+			i++
+		case row[i] == '|':
+			cells = append(cells, strings.TrimSpace(cur.String()))
+			cur.Reset()
+		default:
+			cur.WriteByte(row[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cur.String()))
+}
@@ -0,0 +1,69 @@
+package bubble
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+func TestIssueModelRendersWideTable(t *testing.T) {
+	data, err := os.ReadFile("./testdata/issue-table.json")
+	require.NoError(t, err)
+
+	iss, err := jira.UnmarshalIssue(data)
+	require.NoError(t, err)
+
+	currentTheme = "dark"
+	t.Cleanup(func() { currentTheme = "" })
+
+	m := NewIssueModel("https://jira.example.com")
+	m.Data = iss
+	m.RawWidth, m.RawHeight = 100, 40
+	m.calculateViewportDimensions()
+	m.prepareRenderedLines()
+
+	out := strings.Join(m.renderedLines, "\n")
+	for _, line := range m.renderedLines {
+		assert.LessOrEqual(t, lipgloss.Width(line), m.renderWidth, "line overflows the viewport: %q", line)
+	}
+
+	plain := stripANSI(out)
+	for _, s := range []string{"Environment", "Region", "Owner", "Status", "Notes", "eu-north-1", "degraded", "Reset nightly"} {
+		assert.Contains(t, plain, s)
+	}
+	// The long cell is wrapped, not clipped.
+	assert.Contains(t, plain, "planning doc")
+	assert.Contains(t, plain, "Ping the owner before touching anything.")
+}
+
+func TestSplitTableRow(t *testing.T) {
+	assert.Equal(t, []string{"a", "b | c", "", "d"}, splitTableRow(`| a | b \| c |  | d |`))
+	assert.Equal(t, []string{"a", "b"}, splitTableRow(`a | b`))
+}
+
+func TestIsTableStart(t *testing.T) {
+	assert.False(t, isTableStart([]string{"| a | b |"}, 0))
+	assert.False(t, isTableStart([]string{"| a | b |", "| c | d |"}, 0))
+	assert.True(t, isTableStart([]string{"| a | b |", "|---|:-:|"}, 0))
+}
+
+func stripANSI(s string) string {
+	var out strings.Builder
+	for len(s) > 0 {
+		if s[0] == '\x1b' {
+			if end := strings.IndexByte(s, 'm'); end >= 0 {
+				s = s[end+1:]
+				continue
+			}
+		}
+		out.WriteByte(s[0])
+		s = s[1:]
+	}
+	return out.String()
+}
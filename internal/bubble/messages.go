@@ -21,6 +21,88 @@ type IssueEditedMsg struct {
 	stderr   string
 }
 
+// AssignedToMeMsg reports the issue assigned to the current user, me is the
+// user looked up for it, to be cached.
+type AssignedToMeMsg struct {
+	issueKey string
+	me       *jira.Me
+	err      error
+	stderr   string
+}
+
+// CommentAddedMsg reports the comment posted from the comment pane.
+type CommentAddedMsg struct {
+	issueKey string
+	index    int
+	err      error
+	stderr   string
+}
+
+// IssueReassignedMsg reports the issue bounced to its reporter or previous
+// assignee, a nil assignee means it was unassigned.
+type IssueReassignedMsg struct {
+	issueKey  string
+	index     int
+	assignee  *jira.User
+	unchanged bool
+	err       error
+	stderr    string
+}
+
+// AttachmentsMsg delivers the attachments fetched for the attachment selector.
+type AttachmentsMsg struct {
+	issueKey    string
+	attachments []jira.Attachment
+	err         error
+}
+
+// AttachmentDownloadedMsg reports where the attachment was saved.
+type AttachmentDownloadedMsg struct {
+	filename string
+	path     string
+	err      error
+}
+
+// ViewExportedMsg reports where the report of the current tab was written.
+type ViewExportedMsg struct {
+	path   string
+	issues int
+	err    error
+}
+
+// SummaryEnteredMsg carries the summary typed in the rename prompt.
+type SummaryEnteredMsg struct {
+	issueKey string
+	previous string
+	summary  string
+	err      error
+}
+
+// IssueRenamedMsg reports the summary saved in Jira, previous is restored on failure.
+type IssueRenamedMsg struct {
+	issueKey string
+	index    int
+	previous string
+	err      error
+	stderr   string
+}
+
+// LabelEnteredMsg carries the label typed in the bulk label prompt.
+type LabelEnteredMsg struct {
+	issueKeys []string
+	label     string
+	err       error
+}
+
+// IssuesLabeledMsg reports the issues the label was saved on and the ones that failed.
+type IssuesLabeledMsg struct {
+	index     int
+	label     string
+	issueKeys []string
+	failed    []string
+	err       error
+}
+
 type IssueCreatedMsg struct {
 	err    error
 	stderr string
@@ -28,6 +110,7 @@ type IssueCreatedMsg struct {
 
 type IssueMovedMsg struct {
 	issueKey string
+	state    string
 	err      error
 	stderr   string
 }
@@ -44,17 +127,105 @@ type IssueBacklogToggleMsg struct {
 	stderr   string
 }
 
+type IssueParentClearedMsg struct {
+	issueKey  string
+	parentKey string
+	err       error
+	stderr    string
+}
+
+// IssuePriorityChangedMsg reports the priority change of the issue, priorities
+// are the ones of the instance it was picked from, to be cached.
+type IssuePriorityChangedMsg struct {
+	issueKey   string
+	priority   string
+	priorities []*jira.Priority
+	err        error
+	stderr     string
+}
+
+type IssueTypeChangedMsg struct {
+	issueKey  string
+	issueType string
+	err       error
+	stderr    string
+}
+
+// IssueTypesMsg delivers issue types of the project fetched for the type switcher,
+// current is the type of the issue being changed.
+type IssueTypesMsg struct {
+	project string
+	current jira.IssueType
+	types   []*jira.IssueType
+	err     error
+}
+
+// AssignableUsersMsg delivers users fetched for the assignee selector.
+type AssignableUsersMsg struct {
+	users []*jira.User
+	err   error
+}
+
+// ProjectsMsg delivers projects fetched for the project switcher.
+type ProjectsMsg struct {
+	projects []*jira.Project
+	err      error
+}
+
+// TransitionsMsg delivers transitions fetched for the move selector.
+type TransitionsMsg struct {
+	transitions []*jira.Transition
+	err         error
+}
+
+// ResolutionsMsg delivers resolutions fetched for the resolution selector.
+type ResolutionsMsg struct {
+	resolutions []*jira.Resolution
+	err         error
+}
+
 type SelectedIssueMsg struct{ issue *jira.Issue }
 
 type FuzzySelectorResultMsg struct {
 	item         list.Item
 	selectorType FuzzySelectorType
+	// yank is set when the item was picked with y to be copied
+	yank bool
 }
 
 type IncomingIssueListMsg struct {
-	issues   []*jira.Issue
+	issues []*jira.Issue
+	total  int
+	// err is set if the issues couldn't be fetched.
+	err      error
 	index    int
 	resolver *exp.BoardStateResolver
+	// boardErr is set if the backlog of the tab board couldn't be fetched.
+	boardErr error
+
+	// previous is the list shown before a refresh, nil on the initial load.
+	previous []*jira.Issue
+	// cursorKey is the issue under the cursor before a refresh.
+	cursorKey string
+}
+
+// MoreIssuesMsg delivers the next page of a tab loaded page by page.
+type MoreIssuesMsg struct {
+	index  int
+	issues []*jira.Issue
+	err    error
+	// table is the table the page was fetched for, a refresh replaces it.
+	table *Table
+}
+
+// ParentSummariesMsg delivers the summaries of parents looked up for the PARENT column.
+type ParentSummariesMsg struct {
+	index     int
+	summaries map[string]string
+}
+
+type AutoRefreshMsg struct {
+	id int
 }
 
 type IncomingIssueMsg struct {
@@ -62,6 +233,19 @@ type IncomingIssueMsg struct {
 	index int
 }
 
+// MentionsResolvedMsg tells that the display names of the users mentioned in
+// the issue are cached, so it can be rendered with them.
+type MentionsResolvedMsg struct {
+	issueKey string
+	index    int
+}
+
+// IssueFetchErrorMsg reports an issue that couldn't be loaded, the session goes on.
+type IssueFetchErrorMsg struct {
+	issueKey string
+	err      error
+}
+
 type SetRenderStyleMsg struct {
 	style string
 }
@@ -0,0 +1,68 @@
+package bubble
+
+import (
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// getMouse reads `ui.mouse`, if set a click selects a row of the list and the
+// wheel scrolls the detail pane. It is off by default, capturing the mouse
+// gets in the way of selecting text in the terminal.
+func getMouse() bool {
+	return viper.GetBool("ui.mouse")
+}
+
+// keyAtLine returns the key of the issue shown on line y of the table view,
+// empty if the line isn't a row of an issue.
+func (t *Table) keyAtLine(y int) string {
+	lines := strings.Split(t.View(), "\n")
+	if y < 0 || y >= len(lines) {
+		return ""
+	}
+
+	keys := make(map[string]bool, len(t.issuePool()))
+	for _, iss := range t.issuePool() {
+		keys[iss.Key] = true
+	}
+	for _, field := range strings.Fields(ansi.Strip(lines[y])) {
+		if field = strings.Trim(field, "│"); keys[field] {
+			return field
+		}
+	}
+	return ""
+}
+
+// clickRow selects the issue clicked in the list, y is the line of the screen.
+// The cursor is moved the same way as with the arrow keys, so that the rows
+// don't jump around.
+func (l *IssueList) clickRow(y int) tea.Cmd {
+	table := l.getCurrentTable()
+	if table == nil || table.allIssues == nil {
+		return nil
+	}
+
+	key := table.keyAtLine(y - l.tabBarHeight())
+	target := slices.IndexFunc(table.issuePool(), func(iss *jira.Issue) bool { return iss.Key == key })
+	if key == "" || target < 0 {
+		return nil
+	}
+
+	delta := target - table.GetCursorRow()
+	if delta == 0 {
+		return nil
+	}
+	cmd := table.GetIssueAsync(l.activeTab, delta)
+	table.PrefetchAround(delta)
+	if delta > 0 {
+		table.table.MoveDown(delta)
+	} else {
+		table.table.MoveUp(-delta)
+	}
+	return tea.Batch(cmd, l.fetchMore(l.activeTab))
+}
@@ -0,0 +1,70 @@
+package bubble
+
+import (
+	"fmt"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+
+	"github.com/jorres/jira-tui/api"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// fetchMoreThreshold is how close to the last loaded row the cursor gets
+// before the next page of the tab is fetched.
+const fetchMoreThreshold = 20
+
+// fetchMore fetches the next page of a tab loaded page by page once the cursor
+// nears the end of the loaded issues.
+func (l *IssueList) fetchMore(index int) tea.Cmd {
+	tab, t := l.tabs[index], l.tables[index]
+	if tab.FetchMore == nil || t == nil || t.allIssues == nil || t.fetchingMore {
+		return nil
+	}
+	if len(t.allIssues) >= min(t.total, api.SearchAllCap) {
+		return nil
+	}
+	if t.GetCursorRow() < len(t.issuePool())-fetchMoreThreshold {
+		return nil
+	}
+
+	var jql string
+	if qf, ok := l.activeQuickFilter(index); ok {
+		jql = qf.JQL
+	}
+	t.fetchingMore = true
+	fetch, from := tab.FetchMore, uint(len(t.allIssues))
+	return func() tea.Msg {
+		issues, err := fetch(jql, from)
+		return MoreIssuesMsg{index: index, issues: issues, err: err, table: t}
+	}
+}
+
+// appendIssues adds the fetched page to the table it was fetched for. Issues
+// already listed, e.g. shifted by a new match in between, are skipped.
+func (l *IssueList) appendIssues(msg MoreIssuesMsg) tea.Cmd {
+	t := l.tables[msg.index]
+	if t != msg.table {
+		return nil
+	}
+	t.fetchingMore = false
+	if msg.err != nil {
+		// The page is fetched again once the cursor moves.
+		return l.setStatusMessage(fmt.Sprintf("Unable to load more issues: %s", msg.err))
+	}
+	if len(msg.issues) == 0 {
+		// Nothing more to page in, even if the total says otherwise.
+		t.total = len(t.allIssues)
+		return nil
+	}
+
+	for _, iss := range msg.issues {
+		if !slices.ContainsFunc(t.allIssues, func(listed *jira.Issue) bool { return listed.Key == iss.Key }) {
+			t.allIssues = append(t.allIssues, iss)
+		}
+	}
+	if t.SorterState != SorterInactive {
+		t.filterTableData(t.sorterText)
+	}
+	return t.fetchParentSummaries(msg.index)
+}
@@ -0,0 +1,117 @@
+package bubble
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+
+	"github.com/jorres/jira-tui/api"
+	"github.com/jorres/jira-tui/internal/debug"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// parentLabel renders the parent in the PARENT column as "ABC-1: Epic name".
+// Search results usually carry the summary of the parent, otherwise the one
+// looked up by fetchParentSummaries is used.
+func (t *Table) parentLabel(issue *jira.Issue) string {
+	parent := issue.Fields.Parent
+	summary := parent.Fields.Summary
+	if summary == "" {
+		summary = t.parentSummaries[parent.Key]
+	}
+	if summary == "" {
+		return parent.Key
+	}
+	return fmt.Sprintf("%s: %s", parent.Key, prepareTitle(summary))
+}
+
+// missingParents returns the parents listed in the table whose summary is
+// unknown yet, each one once. Parents listed in the table themselves are
+// remembered right away.
+func (t *Table) missingParents() []string {
+	if !slices.Contains(t.columns, FieldParent) {
+		return nil
+	}
+	if t.parentSummaries == nil {
+		t.parentSummaries = make(map[string]string)
+	}
+	for _, iss := range t.allIssues {
+		t.parentSummaries[iss.Key] = iss.Fields.Summary
+	}
+
+	var keys []string
+	for _, iss := range t.allIssues {
+		parent := iss.Fields.Parent
+		if parent == nil || parent.Fields.Summary != "" || slices.Contains(keys, parent.Key) {
+			continue
+		}
+		if _, ok := t.parentSummaries[parent.Key]; !ok {
+			keys = append(keys, parent.Key)
+		}
+	}
+	return keys
+}
+
+// fetchParentSummaries looks the missing parent summaries up with a single
+// search, so that rows sharing a parent don't fetch it over and over.
+func (t *Table) fetchParentSummaries(index int) tea.Cmd {
+	keys := t.missingParents()
+	if len(keys) == 0 || t.fetcher == nil {
+		return nil
+	}
+
+	return func() tea.Msg {
+		summaries, err := lookupParentSummaries(t.fetcher, keys)
+		if err != nil {
+			debug.Debug("fetching parent summaries failed:", err)
+			return NopMsg{}
+		}
+		return ParentSummariesMsg{index: index, summaries: summaries}
+	}
+}
+
+// lookupParentSummaries searches the summaries of the parents. Parents that
+// can't be found, e.g. hidden from the user, are mapped to an empty summary so
+// that they aren't looked up again. Jira rejects the whole search if one of
+// the keys doesn't exist, the parents are then looked up one by one.
+func lookupParentSummaries(f api.Fetcher, keys []string) (map[string]string, error) {
+	summaries := make(map[string]string, len(keys))
+	for _, key := range keys {
+		summaries[key] = ""
+	}
+
+	jql := fmt.Sprintf("key IN (%s)", strings.Join(keys, ", "))
+	resp, err := api.SearchAll(f, jql, 0, uint(len(keys)))
+	if err == nil {
+		for _, iss := range resp.Issues {
+			if _, ok := summaries[iss.Key]; ok {
+				summaries[iss.Key] = iss.Fields.Summary
+			}
+		}
+		return summaries, nil
+	}
+	if !isBadRequest(err) {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		resp, err := f.Search(fmt.Sprintf("key = %s", key), 0, 1)
+		switch {
+		case err == nil && len(resp.Issues) > 0:
+			summaries[key] = resp.Issues[0].Fields.Summary
+		case err != nil && !isBadRequest(err):
+			// Looked up again on the next refresh.
+			delete(summaries, key)
+		}
+	}
+	return summaries, nil
+}
+
+func isBadRequest(err error) bool {
+	var resp *jira.ErrUnexpectedResponse
+	return errors.As(err, &resp) && resp.StatusCode == http.StatusBadRequest
+}
@@ -0,0 +1,90 @@
+package bubble
+
+import (
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// PeekView shows the issue under the cursor in a popup over the list, it is
+// used when the detail pane is hidden.
+type PeekView struct {
+	RawWidth  int
+	RawHeight int
+
+	issue IssueModel
+
+	PreviousModel tea.Model
+}
+
+// NewPeekView creates a popup with the rendered issue.
+func NewPeekView(prev tea.Model, server string, issue *jira.Issue, width, height int) *PeekView {
+	p := &PeekView{
+		PreviousModel: prev,
+		issue:         NewIssueModel(server),
+	}
+	p.issue, _ = p.issue.Update(issue)
+	p.resize(width, height)
+	return p
+}
+
+// isPeekKey tells if the key opens or closes the popup.
+func isPeekKey(key string) bool {
+	return key == " " || key == "space"
+}
+
+// getDetailPaneVisible reads `ui.list.detail_pane`, the detail pane is shown
+// below the list unless it is disabled.
+func getDetailPaneVisible() bool {
+	if !viper.IsSet("ui.list.detail_pane") {
+		return true
+	}
+	return viper.GetBool("ui.list.detail_pane")
+}
+
+func (p *PeekView) resize(width, height int) {
+	p.RawWidth = width
+	p.RawHeight = height
+
+	// The popup takes 80% of the screen, the border takes 2 more rows
+	p.issue, _ = p.issue.Update(WidgetSizeMsg{
+		Width:  int(float32(width) * 0.8),
+		Height: int(float32(height)*0.8) - 2,
+	})
+}
+
+func (p *PeekView) Init() tea.Cmd {
+	return nil
+}
+
+func (p *PeekView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		p.resize(msg.Width, msg.Height)
+	case tea.KeyMsg:
+		switch key := msg.String(); {
+		case isPeekKey(key), key == "esc", key == "q":
+			return p.PreviousModel, func() tea.Msg {
+				return tea.WindowSizeMsg{Width: p.RawWidth, Height: p.RawHeight}
+			}
+		case key == "j", key == "down":
+			p.issue.scrollDown()
+		case key == "k", key == "up":
+			p.issue.scrollUp()
+		case key == "ctrl+e", key == "ctrl+y", key == "tab", key == "shift+tab", key == "C", key == "L":
+			p.issue, _ = p.issue.Update(msg)
+		}
+	}
+	return p, nil
+}
+
+func (p *PeekView) View() string {
+	popup := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color(getAccentColor())).
+		Render(p.issue.View())
+
+	return lipgloss.Place(p.RawWidth, p.RawHeight, lipgloss.Center, lipgloss.Center, popup)
+}
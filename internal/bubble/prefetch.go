@@ -0,0 +1,158 @@
+package bubble
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/internal/debug"
+)
+
+const (
+	defaultPrefetchRadius      = 5
+	defaultPrefetchConcurrency = 3
+)
+
+// prefetcher loads details of the issues around the cursor into the table cache
+// in background, so that moving the cursor doesn't wait for the network.
+type prefetcher struct {
+	mu sync.Mutex
+
+	// sem bounds the number of concurrent requests to the API
+	sem chan struct{}
+	// inFlight holds keys being fetched right now, to not request them twice
+	inFlight map[string]bool
+
+	// center is the cursor position of the last prefetch, ctx is cancelled
+	// once the cursor jumps away from it
+	center int
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newPrefetcher() *prefetcher {
+	return &prefetcher{
+		sem:      make(chan struct{}, getPrefetchConcurrency()),
+		inFlight: make(map[string]bool),
+	}
+}
+
+func getPrefetchRadius() int {
+	if !viper.IsSet("ui.list.prefetch") {
+		return defaultPrefetchRadius
+	}
+	return max(viper.GetInt("ui.list.prefetch"), 0)
+}
+
+func getPrefetchConcurrency() int {
+	if n := viper.GetInt("ui.list.prefetch_concurrency"); n > 0 {
+		return n
+	}
+	return defaultPrefetchConcurrency
+}
+
+// PrefetchAround schedules fetching of the issues surrounding the row under cursor
+// shifted by shift. If the cursor jumped further than the prefetch radius, requests
+// scheduled for the previous position are cancelled before they hit the API.
+func (t *Table) PrefetchAround(shift int) {
+	radius := getPrefetchRadius()
+	if radius == 0 || t.prefetcher == nil || t.fetcher == nil {
+		return
+	}
+
+	pool := t.issuePool()
+	pos := t.GetCursorRow() + shift
+	if pos < 0 || pos >= len(pool) {
+		return
+	}
+
+	keys := make([]string, 0, 2*radius)
+	for d := 1; d <= radius; d++ {
+		for _, p := range []int{pos + d, pos - d} {
+			if p >= 0 && p < len(pool) {
+				keys = append(keys, pool[p].Key)
+			}
+		}
+	}
+
+	pf := t.prefetcher
+	pf.mu.Lock()
+	if pf.cancel != nil && abs(pos-pf.center) > radius {
+		pf.cancel()
+		pf.cancel = nil
+	}
+	if pf.cancel == nil {
+		pf.ctx, pf.cancel = context.WithCancel(context.Background())
+	}
+	pf.center = pos
+	ctx := pf.ctx
+	pf.mu.Unlock()
+
+	go t.prefetch(ctx, keys)
+}
+
+func (t *Table) prefetch(ctx context.Context, keys []string) {
+	pf := t.prefetcher
+
+	for _, key := range keys {
+		if _, ok := t.cachedIssue(key); ok {
+			continue
+		}
+
+		pf.mu.Lock()
+		if pf.inFlight[key] {
+			pf.mu.Unlock()
+			continue
+		}
+		pf.inFlight[key] = true
+		pf.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			pf.done(key)
+			return
+		case pf.sem <- struct{}{}:
+		}
+
+		go func(key string) {
+			defer func() { <-pf.sem }()
+			defer pf.done(key)
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			iss, err := t.fetchIssue(key)
+			if err != nil {
+				// Most likely we are being rate limited, back off until the next cursor move.
+				debug.Debug("prefetch of", key, "failed:", err)
+				if t.statusLog != nil {
+					t.statusLog.add(fmt.Sprintf("Prefetch of %s failed: %s", key, err))
+				}
+				pf.mu.Lock()
+				if pf.cancel != nil {
+					pf.cancel()
+					pf.cancel = nil
+				}
+				pf.mu.Unlock()
+				return
+			}
+			t.cacheIssue(key, iss)
+		}(key)
+	}
+}
+
+func (pf *prefetcher) done(key string) {
+	pf.mu.Lock()
+	delete(pf.inFlight, key)
+	pf.mu.Unlock()
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,70 @@
+package bubble
+
+import (
+	"github.com/charmbracelet/bubbles/v2/list"
+	tea "github.com/charmbracelet/bubbletea/v2"
+
+	"github.com/jorres/jira-tui/api"
+	"github.com/jorres/jira-tui/internal/config"
+	"github.com/jorres/jira-tui/internal/query"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+const savedQueryLimit = 300
+
+// NewQueryTab creates a tab listing the issues matching a saved query.
+func NewQueryTab(project, name, jql string, fetchEpics func() ([]*jira.Issue, int, error), debug bool) *TabConfig {
+	return &TabConfig{
+		Project:     project,
+		Name:        name,
+		QueryParams: &query.IssueParams{JQL: jql},
+		FetchIssues: func() ([]*jira.Issue, int, error) {
+			resp, err := api.DefaultFetcher(debug).Search(jql, 0, savedQueryLimit)
+			if err != nil {
+				return nil, 0, err
+			}
+			return resp.Issues, resp.Total, nil
+		},
+		FetchEpics: fetchEpics,
+	}
+}
+
+// selectSavedQuery lets the user pick one of the queries saved with `jira query save`.
+func (l *IssueList) selectSavedQuery() (tea.Model, tea.Cmd) {
+	queries := config.SavedQueries()
+	if len(queries) == 0 {
+		return l, l.setStatusMessage("No saved queries, add one with `jira query save NAME --jql ...`")
+	}
+
+	listItems := []list.Item{}
+	for _, q := range queries {
+		listItems = append(listItems, q)
+	}
+	fz := NewFuzzySelectorFrom(l, l.rawWidth, l.rawHeight, listItems, FuzzySelectorQuery)
+	return fz, nil
+}
+
+// openSavedQuery shows the query in a tab of its own, the tab is reused when
+// the query is opened again.
+func (l *IssueList) openSavedQuery(q config.SavedQuery) tea.Cmd {
+	for i, tab := range l.tabs {
+		if tab.Name == q.Name && tab.QueryParams != nil && tab.QueryParams.JQL == q.JQL {
+			tab.hidden = false
+			l.activeTab = i
+			return tea.Batch(l.getCurrentTable().spinner.Tick, l.getCurrentIssueDetailView().spinner.Tick)
+		}
+	}
+
+	l.tabs = append(l.tabs, NewQueryTab(l.Project, q.Name, q.JQL, l.getCurrentTabConfig().FetchEpics, l.debugMode))
+	l.tables = append(l.tables, nil)
+	l.issueDetailViews = append(l.issueDetailViews, IssueModel{})
+	l.activeTab = len(l.tabs) - 1
+
+	cmds := []tea.Cmd{l.reinitTable(l.activeTab), l.reinitIssue(l.activeTab)}
+
+	// The tab bar may have just appeared, so the layout is recalculated.
+	width, height := l.rawWidth, l.rawHeight
+	cmds = append(cmds, func() tea.Msg { return tea.WindowSizeMsg{Width: width, Height: height} })
+
+	return tea.Batch(cmds...)
+}
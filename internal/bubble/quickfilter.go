@@ -0,0 +1,98 @@
+package bubble
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/spf13/viper"
+)
+
+// QuickFilter narrows the issues of the active tab down with a JQL clause,
+// applied with the number keys.
+type QuickFilter struct {
+	Name string `mapstructure:"name"`
+	JQL  string `mapstructure:"jql"`
+}
+
+// maxQuickFilters is the number of filters reachable with the keys 1-9.
+const maxQuickFilters = 9
+
+func defaultQuickFilters() []QuickFilter {
+	return []QuickFilter{
+		{Name: "My open", JQL: "assignee = currentUser() AND statusCategory != Done"},
+		{Name: "Unassigned", JQL: "assignee IS EMPTY"},
+		{Name: "Updated today", JQL: "updated >= startOfDay()"},
+		{Name: "High priority", JQL: "priority IN (Highest, High)"},
+		{Name: "In review", JQL: `status = "In Review"`},
+	}
+}
+
+// getQuickFilters reads `ui.quick_filters`, falling back to the presets above.
+func getQuickFilters() []QuickFilter {
+	if !viper.IsSet("ui.quick_filters") {
+		return defaultQuickFilters()
+	}
+
+	var filters []QuickFilter
+	if err := viper.UnmarshalKey("ui.quick_filters", &filters); err != nil {
+		return defaultQuickFilters()
+	}
+	if len(filters) > maxQuickFilters {
+		filters = filters[:maxQuickFilters]
+	}
+	return filters
+}
+
+// quickFilterIndex maps the keys 1-9 to the index of a quick filter, 0 maps to
+// -1 which clears the active one.
+func quickFilterIndex(key string) int {
+	return int(key[0]-'0') - 1
+}
+
+// activeQuickFilter returns the quick filter applied to the tab, if any.
+func (l *IssueList) activeQuickFilter(index int) (QuickFilter, bool) {
+	idx, ok := l.quickFilters[index]
+	if !ok {
+		return QuickFilter{}, false
+	}
+	filters := getQuickFilters()
+	if idx >= len(filters) {
+		return QuickFilter{}, false
+	}
+	return filters[idx], true
+}
+
+// applyQuickFilter toggles the quick filter on the active tab and reloads it.
+// A negative idx clears the filter.
+func (l *IssueList) applyQuickFilter(idx int) tea.Cmd {
+	tab := l.getCurrentTabConfig()
+	if tab.FetchFiltered == nil {
+		return l.setStatusMessage("Quick filters aren't available in this tab")
+	}
+
+	filters := getQuickFilters()
+	if idx >= len(filters) {
+		return l.setStatusMessage(fmt.Sprintf("No quick filter bound to %d", idx+1))
+	}
+
+	if l.quickFilters == nil {
+		l.quickFilters = make(map[int]int)
+	}
+
+	var status string
+	if active, ok := l.quickFilters[l.activeTab]; idx < 0 || (ok && active == idx) {
+		if !ok {
+			return nil
+		}
+		delete(l.quickFilters, l.activeTab)
+		status = "Quick filter cleared"
+	} else {
+		l.quickFilters[l.activeTab] = idx
+		status = fmt.Sprintf("Quick filter: %s", filters[idx].Name)
+	}
+
+	// A filtered list is a new list, not a refresh whose changes are reported
+	// over the status.
+	l.tables[l.activeTab] = nil
+	return tea.Batch(l.setStatusMessage(status), l.reinitTable(l.activeTab))
+}
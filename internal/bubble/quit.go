@@ -0,0 +1,35 @@
+package bubble
+
+import (
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/spf13/viper"
+)
+
+const quitPrompt = "Quit? (y/n)"
+
+// getConfirmQuit reads `ui.confirm_quit`, if set the user is asked before the
+// list is closed, so that a stray q doesn't end the session.
+func getConfirmQuit() bool {
+	return viper.GetBool("ui.confirm_quit")
+}
+
+// quit closes the list, or asks the user first if quitting needs to be confirmed.
+func (l *IssueList) quit() tea.Cmd {
+	if !getConfirmQuit() {
+		return tea.Quit
+	}
+	l.confirmingQuit = true
+	return nil
+}
+
+// answerQuit handles the key pressed while the quit prompt is shown, y or
+// another ctrl+c quits, any other key dismisses the prompt.
+func (l *IssueList) answerQuit(key string) tea.Cmd {
+	l.confirmingQuit = false
+
+	switch key {
+	case "y", "Y", "ctrl+c":
+		return tea.Quit
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package bubble
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jorres/jira-tui/internal/cmdutil"
+	"github.com/jorres/jira-tui/pkg/jira"
+	"github.com/jorres/jira-tui/pkg/md"
+	"github.com/jorres/md2adf-translator/adf"
+)
+
+// quotableComment is a list item of the comment selector shown before adding
+// a comment. An item without author stands for a comment without a quote,
+// internal ones are posted as service desk internal notes, and so are the
+// replies quoting an internal comment.
+type quotableComment struct {
+	author   string
+	created  string
+	body     string
+	internal bool
+}
+
+func (c quotableComment) Title() string {
+	if c.author == "" && c.internal {
+		return "New internal comment"
+	}
+	if c.author == "" {
+		return "New comment"
+	}
+	title := fmt.Sprintf("%s • %s", c.author, cmdutil.FormatDateTimeHuman(c.created, jira.RFC3339))
+	if c.internal {
+		title += " • internal"
+	}
+	return title
+}
+
+func (c quotableComment) Description() string {
+	if c.author == "" && c.internal {
+		return "Only visible to service desk agents"
+	}
+	if c.author == "" {
+		return "Don't quote anything"
+	}
+	return strings.TrimSpace(strings.SplitN(strings.TrimSpace(c.body), "\n", 2)[0])
+}
+
+func (c quotableComment) FilterValue() string {
+	if c.author == "" && c.internal {
+		return "internal"
+	}
+	return c.author + " " + c.body
+}
+
+// quotableComments lists the loaded comments of the issue newest first, preceded
+// by the items for a comment without a quote.
+func (l *IssueList) quotableComments(iss *jira.Issue) []quotableComment {
+	view := l.getCurrentIssueDetailView()
+	translator := view.adfTranslator()
+
+	comments := iss.Fields.Comment.Comments
+	items := []quotableComment{{}, {internal: true}}
+	for idx := len(comments) - 1; idx >= 0; idx-- {
+		c := comments[idx]
+
+		var body string
+		if adfNode, ok := c.Body.(*adf.ADFNode); ok {
+			body = translator.Translate(adfNode)
+		} else if s, ok := c.Body.(string); ok {
+			body = md.FromJiraMD(s)
+		}
+
+		items = append(items, quotableComment{
+			author:   c.Author.GetDisplayableName(),
+			created:  c.Created,
+			body:     body,
+			internal: c.IsInternal(),
+		})
+	}
+	return items
+}
+
+// quoteComment turns the comment into a markdown blockquote with an attribution
+// line, to be used as the initial body of a reply.
+func quoteComment(c quotableComment) string {
+	var s strings.Builder
+	for _, line := range strings.Split(strings.TrimSpace(c.body), "\n") {
+		if line = strings.TrimRight(line, " \t"); line == "" {
+			s.WriteString(">\n")
+			continue
+		}
+		s.WriteString("> " + line + "\n")
+	}
+	s.WriteString(">\n")
+	s.WriteString(fmt.Sprintf("> — %s\n\n", c.author))
+	return s.String()
+}
@@ -0,0 +1,45 @@
+package bubble
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+func TestQuoteComment(t *testing.T) {
+	c := quotableComment{
+		author: "Jane Doe",
+		body:   "First line\n\n- item  \n",
+	}
+
+	assert.Equal(t, "> First line\n>\n> - item\n>\n> — Jane Doe\n\n", quoteComment(c))
+}
+
+func TestNewCommentItems(t *testing.T) {
+	assert.Equal(t, "New comment", quotableComment{}.Title())
+	assert.Equal(t, "New internal comment", quotableComment{internal: true}.Title())
+}
+
+func TestSelectCommentToCopy(t *testing.T) {
+	iss := &jira.Issue{Key: "TEST-1"}
+	l := &IssueList{issueDetailViews: []IssueModel{{}}}
+
+	m, _ := l.selectCommentToCopy(iss)
+	assert.Same(t, l, m)
+
+	iss.Fields.Comment.Comments = jira.Comments{
+		{Author: jira.User{DisplayName: "Jane Doe"}, Body: "First {color:red}line{color}\n\n* item"},
+		{Author: jira.User{DisplayName: "John Roe"}, Body: "Second"},
+	}
+	m, _ = l.selectCommentToCopy(iss)
+	fz, ok := m.(*FuzzySelector)
+	assert.True(t, ok)
+	assert.Equal(t, FuzzySelectorCopyComment, fz.selectorType)
+
+	items := fz.list.Items()
+	assert.Len(t, items, 2)
+	assert.Equal(t, "John Roe", items[0].(quotableComment).author)
+	assert.NotContains(t, items[1].(quotableComment).body, "\x1b")
+}
@@ -0,0 +1,58 @@
+package bubble
+
+import (
+	"errors"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// assignToReporter bounces the issue back to whoever filed it.
+func (l *IssueList) assignToReporter(issue *jira.Issue) tea.Cmd {
+	reporter := issue.Fields.Reporter
+	if reporter.AccountID == "" && reporter.Login == "" {
+		return l.setStatusMessage(fmt.Sprintf("%s has no reporter", issue.Key))
+	}
+
+	user := &jira.User{AccountID: reporter.AccountID, Name: reporter.Login, DisplayName: reporter.Name}
+	index := l.activeTab
+	return func() tea.Msg {
+		if err := l.assignToUser(user, issue); err != nil {
+			return IssueReassignedMsg{issueKey: issue.Key, index: index, err: err, stderr: err.Error()}
+		}
+		return IssueReassignedMsg{issueKey: issue.Key, index: index, assignee: user}
+	}
+}
+
+// assignToPreviousAssignee swaps the assignee back to whoever had the issue
+// before the last change, as recorded in the changelog.
+func (l *IssueList) assignToPreviousAssignee(issue *jira.Issue) tea.Cmd {
+	index := l.activeTab
+	return func() tea.Msg {
+		user, err := l.c.PreviousAssignee(issue.Key)
+		if errors.Is(err, jira.ErrNoResult) {
+			return IssueReassignedMsg{issueKey: issue.Key, index: index, unchanged: true}
+		}
+		if err == nil {
+			err = l.assignToUser(user, issue)
+		}
+		if err != nil {
+			return IssueReassignedMsg{issueKey: issue.Key, index: index, err: err, stderr: err.Error()}
+		}
+		return IssueReassignedMsg{issueKey: issue.Key, index: index, assignee: user}
+	}
+}
+
+// reassignedStatus confirms who the issue went to.
+func reassignedStatus(msg IssueReassignedMsg) string {
+	switch {
+	case msg.unchanged:
+		return fmt.Sprintf("%s was never reassigned", msg.issueKey)
+	case msg.assignee == nil:
+		return fmt.Sprintf("%s unassigned", msg.issueKey)
+	default:
+		return fmt.Sprintf("%s assigned to %s", msg.issueKey, msg.assignee.DisplayName)
+	}
+}
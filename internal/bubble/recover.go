@@ -0,0 +1,44 @@
+package bubble
+
+import (
+	"fmt"
+	"runtime/debug"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+)
+
+// recoverModel is the backstop for panics in the models of the program: instead
+// of tearing the whole UI down, the panic is shown in the error modal and the
+// model that panicked is restored once it's closed.
+type recoverModel struct {
+	model  tea.Model
+	width  int
+	height int
+}
+
+func (r recoverModel) Init() tea.Cmd {
+	return r.model.Init()
+}
+
+func (r recoverModel) Update(msg tea.Msg) (m tea.Model, cmd tea.Cmd) {
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		r.width, r.height = size.Width, size.Height
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			errorModel := NewErrorModel(r.model, fmt.Sprintf("unexpected error: %v", p), string(debug.Stack()), r.width, r.height)
+			m, cmd = recoverModel{model: errorModel, width: r.width, height: r.height}, nil
+		}
+	}()
+
+	next, cmd := r.model.Update(msg)
+	return recoverModel{model: next, width: r.width, height: r.height}, cmd
+}
+
+func (r recoverModel) View() string {
+	if v, ok := r.model.(tea.ViewModel); ok {
+		return v.View()
+	}
+	return ""
+}
@@ -0,0 +1,57 @@
+package bubble
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/spf13/viper"
+)
+
+// getAutoRefreshInterval reads `ui.auto_refresh_seconds`, 0 disables auto-refresh.
+func getAutoRefreshInterval() time.Duration {
+	return time.Duration(max(viper.GetInt("ui.auto_refresh_seconds"), 0)) * time.Second
+}
+
+// scheduleAutoRefresh arms the next auto-refresh tick. Ticks from earlier
+// schedules are recognized by their id and dropped.
+func (l *IssueList) scheduleAutoRefresh() tea.Cmd {
+	interval := getAutoRefreshInterval()
+	if interval == 0 {
+		return nil
+	}
+
+	l.autoRefreshID++
+	l.autoRefreshDue = time.Now().Add(interval)
+
+	id := l.autoRefreshID
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return AutoRefreshMsg{id: id}
+	})
+}
+
+// resumeAutoRefresh re-arms auto-refresh if its tick got lost. Modals (help,
+// selectors, errors) swallow the ticks delivered while they are open, which
+// pauses auto-refresh until the user gets back to the list.
+func (l *IssueList) resumeAutoRefresh() tea.Cmd {
+	if l.autoRefreshDue.IsZero() || time.Since(l.autoRefreshDue) < time.Second {
+		return nil
+	}
+	return l.scheduleAutoRefresh()
+}
+
+// autoRefreshPaused tells if refreshing now would yank the state from under the user,
+// e.g. while they are typing a filter or the list is still loading.
+func (l *IssueList) autoRefreshPaused() bool {
+	t := l.getCurrentTable()
+	return t == nil || t.allIssues == nil || t.SorterState != SorterInactive
+}
+
+func (l *IssueList) handleAutoRefresh(msg AutoRefreshMsg) tea.Cmd {
+	if msg.id != l.autoRefreshID {
+		return nil
+	}
+	if l.autoRefreshPaused() {
+		return l.scheduleAutoRefresh()
+	}
+	return tea.Batch(l.reinitTable(l.activeTab), l.scheduleAutoRefresh())
+}
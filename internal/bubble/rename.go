@@ -0,0 +1,84 @@
+package bubble
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// summaryPrompt asks for the new summary of an issue on the terminal released
+// by the UI, it satisfies tea.ExecCommand.
+type summaryPrompt struct {
+	current string
+	answer  string
+}
+
+func (p *summaryPrompt) Run() error {
+	return survey.AskOne(
+		&survey.Input{Message: "Summary", Default: p.current},
+		&p.answer,
+		survey.WithValidator(survey.Required),
+	)
+}
+
+func (p *summaryPrompt) SetStdin(io.Reader)  {}
+func (p *summaryPrompt) SetStdout(io.Writer) {}
+func (p *summaryPrompt) SetStderr(io.Writer) {}
+
+// renameIssue prompts for the new summary of the issue, it is a lighter
+// alternative to editing the whole issue.
+func (l *IssueList) renameIssue(iss *jira.Issue) tea.Cmd {
+	p := &summaryPrompt{current: iss.Fields.Summary}
+	return tea.Exec(p, func(err error) tea.Msg {
+		return SummaryEnteredMsg{issueKey: iss.Key, previous: iss.Fields.Summary, summary: strings.TrimSpace(p.answer), err: err}
+	})
+}
+
+// setSummary updates the summary of the issue, so the table shows the new one
+// before the issue is fetched again.
+func (t *Table) setSummary(key, summary string) {
+	for _, iss := range t.allIssues {
+		if iss.Key == key {
+			iss.Fields.Summary = summary
+		}
+	}
+	if iss, ok := t.cachedIssue(key); ok {
+		iss.Fields.Summary = summary
+	}
+}
+
+// applySummary renames the issue right away in the table and saves the summary in background.
+func (l *IssueList) applySummary(msg SummaryEnteredMsg) tea.Cmd {
+	if errors.Is(msg.err, terminal.InterruptErr) || msg.summary == "" || msg.summary == msg.previous {
+		return nil
+	}
+	if msg.err != nil {
+		return func() tea.Msg {
+			return IssueRenamedMsg{issueKey: msg.issueKey, err: msg.err, stderr: msg.err.Error()}
+		}
+	}
+
+	index := l.activeTab
+	l.tables[index].setSummary(msg.issueKey, msg.summary)
+
+	return func() tea.Msg {
+		var err error
+		edr := &jira.EditRequest{Summary: msg.summary}
+		if viper.GetString("installation") == jira.InstallationTypeLocal {
+			err = l.c.EditV2(msg.issueKey, edr)
+		} else {
+			err = l.c.Edit(msg.issueKey, edr)
+		}
+		if err != nil {
+			return IssueRenamedMsg{issueKey: msg.issueKey, index: index, previous: msg.previous, err: err, stderr: err.Error()}
+		}
+		return IssueRenamedMsg{issueKey: msg.issueKey, index: index}
+	}
+}
@@ -1,26 +1,29 @@
 package bubble
 
 import (
-	"bytes"
+	"errors"
 	"fmt"
+	"maps"
 	"os"
-	"os/exec"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/atotto/clipboard"
 	"github.com/jorres/jira-tui/api"
 	"github.com/jorres/jira-tui/internal/cmdutil"
+	"github.com/jorres/jira-tui/internal/config"
 	"github.com/jorres/jira-tui/internal/debug"
 	"github.com/jorres/jira-tui/internal/exp"
+	"github.com/jorres/jira-tui/internal/history"
 	"github.com/jorres/jira-tui/internal/query"
 	"github.com/jorres/jira-tui/pkg/jira"
-	"github.com/jorres/jira-tui/pkg/jira/filter/issue"
 	"github.com/spf13/viper"
 
 	"github.com/charmbracelet/bubbles/v2/list"
 	"github.com/charmbracelet/bubbles/v2/spinner"
 	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/charmbracelet/colorprofile"
 	"github.com/charmbracelet/lipgloss/v2"
 )
 
@@ -52,10 +55,31 @@ type TabConfig struct {
 	Columns     []string
 	BoardId     int
 	QueryParams *query.IssueParams
-	FetchIssues func() ([]*jira.Issue, int)
-	FetchEpics  func() ([]*jira.Issue, int)
+	// FetchIssues and FetchEpics return the issues along with the number of
+	// issues matching the query, errors are shown in the UI.
+	FetchIssues func() ([]*jira.Issue, int, error)
+	FetchEpics  func() ([]*jira.Issue, int, error)
+
+	// ForProject rebuilds the tab to show issues of another project,
+	// nil if the tab can't be switched, e.g. the tab of recent issues.
+	ForProject func(project string) *TabConfig
+
+	// FetchFiltered fetches the issues of the tab narrowed down with a quick
+	// filter, nil if the tab can't be filtered.
+	FetchFiltered func(jql string) ([]*jira.Issue, int, error)
+
+	// FetchMore fetches the next page of the tab starting at from, narrowed
+	// down with the quick filter jql if set. Nil if the tab is loaded at once.
+	FetchMore func(jql string, from uint) ([]*jira.Issue, error)
 
 	BoardStateResolver *exp.BoardStateResolver
+
+	// hidden leaves the tab out of the tab bar, see tabs.go
+	hidden bool
+
+	// boardErrShown is set once the user was told the board can't be fetched,
+	// so that refreshes don't repeat the message.
+	boardErrShown bool
 }
 
 func (tc *TabConfig) getColumns() []string {
@@ -74,6 +98,11 @@ type IssueList struct {
 	// Tab management
 	tabs      []*TabConfig
 	activeTab int
+	// tabOrder is the order the tabs are shown in as indexes of tabs, the
+	// tabs keep their index so that messages of pending fetches find them.
+	tabOrder []int
+	// tabsRearranged is set once tabs are moved or hidden, to save the layout on quit
+	tabsRearranged bool
 
 	// Per-tab state
 	tables           []*Table
@@ -91,43 +120,143 @@ type IssueList struct {
 	// Status message fields
 	statusMessage string
 	statusTimer   *time.Timer
+	// statusLog keeps the messages for the log panel, see statuslog.go
+	statusLog *statusLog
 
 	c *jira.Client
-
-	cachedAllUsers []*jira.User
+	// fetcher loads the issues of the tables, see WithFetcher
+	fetcher api.Fetcher
+	// debugMode is passed on to the tabs opened from the UI
+	debugMode bool
+	// viewed holds when the issues were last opened, see loadViewed
+	viewed map[string]time.Time
+
+	cachedAllUsers    []*jira.User
+	cachedPriorities  []*jira.Priority
+	cachedMe          *jira.Me
+	cachedProjects    []*jira.Project
+	cachedResolutions []*jira.Resolution
+	// cachedIssueTypes maps project keys to their issue types
+	cachedIssueTypes map[string][]*jira.IssueType
+
+	// pendingMove waits for the resolution to be selected, see transition.go
+	pendingMove *pendingMove
+
+	// quickFilters maps tabs to the index of the quick filter applied to them
+	quickFilters map[int]int
+
+	// detailPaneHidden gives the whole screen to the list, issues are shown
+	// in a popup instead, see peek.go
+	detailPaneHidden bool
+
+	// confirmingQuit is set while the quit prompt is shown, see quit.go
+	confirmingQuit bool
+
+	// splitRatio is the share of the screen taken by the list, see split.go
+	splitRatio float64
+	// splitResized is set once the split is resized, to save the ratio on quit
+	splitResized bool
+
+	// readOnly disables all actions that modify issues, e.g. when
+	// the data is served from local fixtures.
+	readOnly bool
+
+	// Auto-refresh state, see refresh.go
+	autoRefreshID  int
+	autoRefreshDue time.Time
 }
 
-func RunMainUI(project, server string, total int, tabs []*TabConfig, timezone string, debugMode bool) {
+func newIssueList(project, server string, total int, tabs []*TabConfig, debugMode, readOnly bool) *IssueList {
 	l := &IssueList{
 		Project: project,
 		Server:  server,
 		Total:   total,
 
 		c:                api.DefaultClient(debugMode),
+		fetcher:          api.DefaultFetcher(debugMode),
+		debugMode:        debugMode,
+		viewed:           loadViewed(),
 		tabs:             tabs,
 		activeTab:        0,
 		tables:           make([]*Table, len(tabs)),
 		issueDetailViews: make([]IssueModel, len(tabs)),
+		readOnly:         readOnly,
+		detailPaneHidden: !getDetailPaneVisible(),
+		statusLog:        &statusLog{},
 	}
+	l.applyTabLayout()
+	return l
+}
+
+func RunMainUI(project, server string, total int, tabs []*TabConfig, timezone string, debugMode, readOnly bool) {
+	l := newIssueList(project, server, total, tabs, debugMode, readOnly)
 
 	detect := tea.NewProgram(DetectColorModel{})
 	_, _ = detect.Run()
 
-	p := tea.NewProgram(l, tea.WithAltScreen())
+	opts := []tea.ProgramOption{tea.WithAltScreen()}
+	if !cmdutil.ColorEnabled() {
+		opts = append(opts, tea.WithColorProfile(colorprofile.Ascii))
+	}
+	if getMouse() {
+		opts = append(opts, tea.WithMouseCellMotion())
+	}
+	p := tea.NewProgram(recoverModel{model: l}, opts...)
 
 	_, err := p.Run()
 	if err != nil {
 		fmt.Println("Error running program:", err)
 		os.Exit(1)
 	}
+
+	if !readOnly {
+		if err := l.saveTabLayout(); err != nil {
+			cmdutil.Warn("Unable to save the tab layout: %s", err)
+		}
+		if err := l.saveSplitRatio(); err != nil {
+			cmdutil.Warn("Unable to save the split ratio: %s", err)
+		}
+	}
 }
 
 func (l *IssueList) reinitTable(index int) tea.Cmd {
 	const tableHelpText = "?: toggle help"
 	tabConfig := l.tabs[index]
-	table := NewTable(WithTableHelpText(tableHelpText))
+
+	// Remember what was shown before, to tell the user what the refresh changed.
+	// Nothing is remembered on the initial load.
+	var (
+		previous  []*jira.Issue
+		cursorKey string
+	)
+	if l.tables[index] != nil {
+		previous = l.tables[index].allIssues
+		cursorKey = l.tables[index].getKeyUnderCursorWithShift(0)
+	}
+
+	opts := []TableOption{
+		WithTableHelpText(tableHelpText),
+		WithSummaryMaxWidth(getSummaryMaxWidth()),
+	}
+	if getOSC8Links() {
+		opts = append(opts, WithIssueLinks(l.Server))
+	}
+	if l.statusLog != nil {
+		opts = append(opts, WithStatusLog(l.statusLog))
+	}
+	if l.fetcher != nil {
+		opts = append(opts, WithFetcher(l.fetcher))
+	}
+	table := NewTable(opts...)
 	table.SetColumns(tabConfig.getColumns())
+	table.SetColumnWeights(getColumnWeights())
+	table.SetColumnFormats(getColumnFormats())
 	table.SetTimezone("Local")
+	if l.tables[index] != nil {
+		// Summaries of parents rarely change, they are kept across refreshes.
+		table.parentSummaries = l.tables[index].parentSummaries
+		table.marked = l.tables[index].marked
+	}
 	l.tables[index] = table
 
 	var tableUpdateCmd tea.Cmd
@@ -140,21 +269,60 @@ func (l *IssueList) reinitTable(index int) tea.Cmd {
 
 	cmd2 := table.spinner.Tick
 
+	fetch := tabConfig.FetchIssues
+	if qf, ok := l.activeQuickFilter(index); ok && tabConfig.FetchFiltered != nil {
+		fetch = func() ([]*jira.Issue, int, error) { return tabConfig.FetchFiltered(qf.JQL) }
+	}
+
 	return tea.Batch(tableUpdateCmd, cmd2, func() tea.Msg {
-		tabConfig.BoardStateResolver = exp.CreateBoardStateResolver(l.c, tabConfig.BoardId, tabConfig.QueryParams)
+		var boardErr error
+		tabConfig.BoardStateResolver, boardErr = exp.CreateBoardStateResolver(l.c, tabConfig.BoardId, tabConfig.QueryParams)
 
-		issues, _ := tabConfig.FetchIssues()
-		return IncomingIssueListMsg{issues: issues, index: index, resolver: tabConfig.BoardStateResolver}
+		issues, total, err := fetch()
+		return IncomingIssueListMsg{issues: issues, total: total, err: err, index: index, resolver: tabConfig.BoardStateResolver, boardErr: boardErr, previous: previous, cursorKey: cursorKey}
 	})
 }
 
+// diffIssueKeys counts issues that appeared in and disappeared from next compared to prev.
+func diffIssueKeys(prev, next []*jira.Issue) (added, removed int) {
+	seen := make(map[string]struct{}, len(prev))
+	for _, iss := range prev {
+		seen[iss.Key] = struct{}{}
+	}
+	for _, iss := range next {
+		if _, ok := seen[iss.Key]; ok {
+			delete(seen, iss.Key)
+		} else {
+			added++
+		}
+	}
+	return added, len(seen)
+}
+
+// refreshSummary describes the changes of a tab after a refresh, e.g. "3 new, 1 removed".
+// It returns an empty string if the set of issues is the same.
+func refreshSummary(prev, next []*jira.Issue) string {
+	added, removed := diffIssueKeys(prev, next)
+
+	var parts []string
+	if added > 0 {
+		parts = append(parts, fmt.Sprintf("%d new", added))
+	}
+	if removed > 0 {
+		parts = append(parts, fmt.Sprintf("%d removed", removed))
+	}
+	return strings.Join(parts, ", ")
+}
+
 func (l *IssueList) reinitOnlyOneIssue(index int, issueKey string) tea.Cmd {
-	newIssue, err := api.ProxyGetIssue(api.DefaultClient(false), issueKey, issue.NewNumCommentsFilter(10))
+	newIssue, err := l.tables[index].fetchIssue(issueKey)
 	if err != nil {
-		panic(err)
+		return func() tea.Msg {
+			return IssueFetchErrorMsg{issueKey: issueKey, err: err}
+		}
 	}
 
-	delete(l.tables[index].issueCache, issueKey)
+	l.tables[index].evictIssue(issueKey)
 
 	for i, oldIssue := range l.tables[index].allIssues {
 		if oldIssue.Key == newIssue.Key {
@@ -175,6 +343,7 @@ func (l *IssueList) reinitIssue(index int) tea.Cmd {
 	var issueUpdateCmd tea.Cmd
 	cmds := []tea.Cmd{}
 	l.issueDetailViews[index] = NewIssueModel(l.Server)
+	l.issueDetailViews[index].viewed = l.viewed
 	l.issueDetailViews[index], issueUpdateCmd = l.issueDetailViews[index].Update(WidgetSizeMsg{
 		Height: l.previewHeight,
 		Width:  l.rawWidth,
@@ -187,6 +356,9 @@ func (l *IssueList) reinitIssue(index int) tea.Cmd {
 // setStatusMessage sets a temporary status message that will be cleared after 1 second
 func (l *IssueList) setStatusMessage(message string) tea.Cmd {
 	l.statusMessage = message
+	if l.statusLog != nil {
+		l.statusLog.add(message)
+	}
 
 	// Clear any existing timer
 	if l.statusTimer != nil {
@@ -208,6 +380,7 @@ func (l *IssueList) Init() tea.Cmd {
 		cmds = append(cmds, l.reinitTable(i))
 		cmds = append(cmds, l.reinitIssue(i))
 	}
+	cmds = append(cmds, l.scheduleAutoRefresh())
 	return tea.Batch(cmds...)
 }
 
@@ -232,29 +405,8 @@ const (
 	issueDetailMode
 )
 
-// execCommandWithStderr executes a command and captures both stdout and stderr
-func execCommandWithStderr(args []string, msgConstructor func(error, string) tea.Msg) tea.Cmd {
-	c := exec.Command("jira", args...)
-	var stderr bytes.Buffer
-	c.Stderr = &stderr
-	return tea.ExecProcess(c, func(err error) tea.Msg {
-		stderrOutput := stderr.String()
-		return msgConstructor(err, stderrOutput)
-	})
-}
-
 func (l *IssueList) editIssue(issue *jira.Issue) tea.Cmd {
-	args := []string{}
-
-	config := viper.GetString("config")
-	if config != "" {
-		args = append(args,
-			"-c",
-			config,
-		)
-	}
-
-	args = append(args,
+	args := childArgs(
 		"issue",
 		"edit",
 		issue.Key,
@@ -266,17 +418,7 @@ func (l *IssueList) editIssue(issue *jira.Issue) tea.Cmd {
 }
 
 func (l *IssueList) createIssue(project string) tea.Cmd {
-	args := []string{}
-
-	config := viper.GetString("config")
-	if config != "" {
-		args = append(args,
-			"-c",
-			config,
-		)
-	}
-
-	args = append(args,
+	args := childArgs(
 		"issue",
 		"create",
 		fmt.Sprintf("-p%s", project),
@@ -287,27 +429,40 @@ func (l *IssueList) createIssue(project string) tea.Cmd {
 	})
 }
 
-func (l *IssueList) addComment(iss *jira.Issue) tea.Cmd {
-	args := []string{}
+// selectCommentToQuote lets the user pick a comment to quote before adding
+// a new one, or to add an internal comment instead. The selector is shown on
+// issues without comments too, the internal comment is picked there.
+func (l *IssueList) selectCommentToQuote(iss *jira.Issue) (tea.Model, tea.Cmd) {
+	comments := l.quotableComments(iss)
 
-	config := viper.GetString("config")
-	if config != "" {
-		args = append(args,
-			"-c",
-			config,
-		)
+	listItems := []list.Item{}
+	for _, c := range comments {
+		listItems = append(listItems, c)
 	}
+	fz := NewFuzzySelectorFrom(l, l.rawWidth, l.rawHeight, listItems, FuzzySelectorComment)
+	return fz, nil
+}
 
-	args = append(args,
-		"issue",
-		"comment",
-		"add",
-		iss.Key,
-	)
+// selectCommentToCopy lets the user pick a comment to copy as markdown.
+func (l *IssueList) selectCommentToCopy(iss *jira.Issue) (tea.Model, tea.Cmd) {
+	// The first items stand for new comments, there is nothing to copy there.
+	comments := l.quotableComments(iss)[2:]
+	if len(comments) == 0 {
+		return l, l.setStatusMessage(fmt.Sprintf("%s has no comments", iss.Key))
+	}
 
-	return execCommandWithStderr(args, func(err error, stderr string) tea.Msg {
-		return IssueEditedMsg{issueKey: iss.Key, err: err, stderr: stderr}
-	})
+	listItems := make([]list.Item, 0, len(comments))
+	for _, c := range comments {
+		listItems = append(listItems, c)
+	}
+	fz := NewFuzzySelectorFrom(l, l.rawWidth, l.rawHeight, listItems, FuzzySelectorCopyComment)
+	return fz, nil
+}
+
+// addComment opens the comment pane over the list, prefilled with the quote if any.
+func (l *IssueList) addComment(iss *jira.Issue, quote string, internal bool) (tea.Model, tea.Cmd) {
+	pane := NewCommentPane(l, iss.Key, quote, internal, l.rawWidth, l.rawHeight)
+	return pane, pane.Init()
 }
 
 func (l *IssueList) toggleBacklogState(issue *jira.Issue) tea.Cmd {
@@ -323,28 +478,6 @@ func (l *IssueList) toggleBacklogState(issue *jira.Issue) tea.Cmd {
 	}
 }
 
-func (l *IssueList) moveIssue(issue *jira.Issue) tea.Cmd {
-	args := []string{}
-
-	config := viper.GetString("config")
-	if config != "" {
-		args = append(args,
-			"-c",
-			config,
-		)
-	}
-
-	args = append(args,
-		"issue",
-		"move",
-		issue.Key,
-	)
-
-	return execCommandWithStderr(args, func(err error, stderr string) tea.Msg {
-		return IssueMovedMsg{issueKey: issue.Key, err: err, stderr: stderr}
-	})
-}
-
 func (l *IssueList) processError(err error, stderr string) (tea.Model, tea.Cmd) {
 	// we don't want to draw the error message border if user just pressed ctrl+c,
 	// this is not an "error" that user expects
@@ -357,17 +490,7 @@ func (l *IssueList) processError(err error, stderr string) (tea.Model, tea.Cmd)
 }
 
 func (l *IssueList) assignToEpic(epicKey string, issue *jira.Issue) tea.Cmd {
-	args := []string{}
-
-	config := viper.GetString("config")
-	if config != "" {
-		args = append(args,
-			"-c",
-			config,
-		)
-	}
-
-	args = append(args,
+	args := childArgs(
 		"epic",
 		"add",
 		epicKey,
@@ -379,47 +502,255 @@ func (l *IssueList) assignToEpic(epicKey string, issue *jira.Issue) tea.Cmd {
 	})
 }
 
-func (l *IssueList) assignToUser(user *jira.User, issue *jira.Issue) {
-	var err error
-	if viper.GetString("installation") == jira.InstallationTypeLocal {
-		err = l.c.AssignIssueV2(issue.Key, user.Name)
-	} else {
-		err = l.c.AssignIssue(issue.Key, user.AccountID)
+// assignToUser sets the assignee of the issue, nil unassigns it.
+func (l *IssueList) assignToUser(user *jira.User, issue *jira.Issue) error {
+	local := viper.GetString("installation") == jira.InstallationTypeLocal
+	switch {
+	case user == nil && local:
+		return l.c.AssignIssueV2(issue.Key, jira.AssigneeNone)
+	case user == nil:
+		return l.c.AssignIssue(issue.Key, jira.AssigneeNone)
+	case local:
+		return l.c.AssignIssueV2(issue.Key, user.Name)
+	default:
+		return l.c.AssignIssue(issue.Key, user.AccountID)
 	}
+}
 
-	if err != nil {
-		cmdutil.ExitIfError(err)
+// assignToMe assigns the issue to the current user in background. The user is
+// looked up along with the first assignment and cached for the following ones.
+func (l *IssueList) assignToMe(issue *jira.Issue) tea.Cmd {
+	me := l.cachedMe
+	return func() tea.Msg {
+		var err error
+		if me == nil {
+			if me, err = l.c.Me(); err != nil {
+				return AssignedToMeMsg{issueKey: issue.Key, err: err, stderr: err.Error()}
+			}
+		}
+		if err = l.assignToUser(&jira.User{AccountID: me.AccountID, Name: me.Login}, issue); err != nil {
+			return AssignedToMeMsg{issueKey: issue.Key, me: me, err: err, stderr: err.Error()}
+		}
+		return AssignedToMeMsg{issueKey: issue.Key, me: me}
 	}
 }
 
-func (l *IssueList) SafelyGetAssignableUsers(issueKey string) ([]*jira.User, error) {
-	var err error
+// fetchAssignableUsers loads the users the issue can be assigned to in background,
+// the result is cached on AssignableUsersMsg.
+func (l *IssueList) fetchAssignableUsers(issueKey string) tea.Cmd {
+	return func() tea.Msg {
+		users, err := l.c.GetAssignableToIssue(issueKey)
+		return AssignableUsersMsg{users: users, err: err}
+	}
+}
+
+// selectAssignee opens the user selector. Until users are loaded for the first
+// time the selector shows a spinner instead of freezing the UI.
+func (l *IssueList) selectAssignee(iss *jira.Issue) (tea.Model, tea.Cmd) {
 	if l.cachedAllUsers == nil {
-		l.cachedAllUsers, err = l.c.GetAssignableToIssue(issueKey)
+		fz := NewFuzzySelectorFrom(l, l.rawWidth, l.rawHeight, []list.Item{}, FuzzySelectorUser)
+		return fz, tea.Batch(fz.list.StartSpinner(), l.fetchAssignableUsers(iss.Key))
+	}
+
+	fz := NewFuzzySelectorFrom(l, l.rawWidth, l.rawHeight, usersToItems(l.cachedAllUsers), FuzzySelectorUser)
+	return fz, nil
+}
+
+// selectProject opens the project switcher, loading the projects in background
+// the first time.
+func (l *IssueList) selectProject() (tea.Model, tea.Cmd) {
+	if !slices.ContainsFunc(l.tabs, func(tab *TabConfig) bool { return tab.ForProject != nil }) {
+		return l, l.setStatusMessage("Switching projects is not available")
+	}
+
+	if l.cachedProjects == nil {
+		fz := NewFuzzySelectorFrom(l, l.rawWidth, l.rawHeight, []list.Item{}, FuzzySelectorProject)
+		return fz, tea.Batch(fz.list.StartSpinner(), func() tea.Msg {
+			projects, err := l.c.Project()
+			return ProjectsMsg{projects: projects, err: err}
+		})
+	}
+
+	fz := NewFuzzySelectorFrom(l, l.rawWidth, l.rawHeight, projectsToItems(l.cachedProjects), FuzzySelectorProject)
+	return fz, nil
+}
+
+// selectCommentAuthor lets the user narrow the comments of the issue down to
+// the ones of a single author.
+func (l *IssueList) selectCommentAuthor() (tea.Model, tea.Cmd) {
+	view := l.getCurrentIssueDetailView()
+	if view.Data == nil {
+		return l, l.setStatusMessage("The issue is still loading")
+	}
+	if len(view.Data.Fields.Comment.Comments) == 0 {
+		return l, l.setStatusMessage("The issue has no comments")
+	}
+
+	var items []list.Item
+	for _, a := range view.commentAuthors() {
+		items = append(items, a)
+	}
+	return NewFuzzySelectorFrom(l, l.rawWidth, l.rawHeight, items, FuzzySelectorCommentAuthor), nil
+}
+
+// switchProject rebinds the tabs to the project and reloads them.
+func (l *IssueList) switchProject(project *jira.Project) tea.Cmd {
+	if project.Key == l.Project {
+		return nil
+	}
+
+	// Child commands, e.g. issue create, pick the project up from the config
+	viper.Set("project.key", project.Key)
+	viper.Set("project.type", project.Type)
+	l.Project = project.Key
+	l.cachedAllUsers = nil
+	l.quickFilters = nil
+
+	cmds := []tea.Cmd{l.setStatusMessage(fmt.Sprintf("Switched to project %s", project.Key))}
+	for i, tab := range l.tabs {
+		if tab.ForProject == nil {
+			continue
+		}
+		l.tabs[i] = tab.ForProject(project.Key)
+		// there is nothing to compare the issues of another project with
+		l.tables[i] = nil
+		cmds = append(cmds, l.reinitTable(i), l.reinitIssue(i))
+	}
+	return tea.Batch(cmds...)
+}
+
+func projectsToItems(projects []*jira.Project) []list.Item {
+	listItems := []list.Item{}
+	for _, project := range projects {
+		listItems = append(listItems, project)
+	}
+	return listItems
+}
+
+func usersToItems(users []*jira.User) []list.Item {
+	listItems := []list.Item{}
+	for _, user := range users {
+		listItems = append(listItems, user)
+	}
+	return listItems
+}
+
+// nextPriority returns the priority following current in the list, wrapping around.
+// Unknown or empty current priority starts the cycle from the beginning.
+func nextPriority(priorities []*jira.Priority, current string) string {
+	for i, p := range priorities {
+		if p.Name == current {
+			return priorities[(i+1)%len(priorities)].Name
+		}
+	}
+	return priorities[0].Name
+}
+
+// cyclePriority sets the priority following the current one of the issue. The
+// priorities of the instance are fetched along with the first change and cached
+// for the following ones.
+func (l *IssueList) cyclePriority(issue *jira.Issue) tea.Cmd {
+	priorities := l.cachedPriorities
+	return func() tea.Msg {
+		var err error
+		if priorities == nil {
+			if priorities, err = l.c.GetPriorities(); err != nil {
+				return IssuePriorityChangedMsg{issueKey: issue.Key, err: err, stderr: err.Error()}
+			}
+		}
+		if len(priorities) == 0 {
+			return IssuePriorityChangedMsg{issueKey: issue.Key, priorities: priorities}
+		}
+
+		next := nextPriority(priorities, issue.Fields.Priority.Name)
+		edr := &jira.EditRequest{Priority: next}
+		if viper.GetString("installation") == jira.InstallationTypeLocal {
+			err = l.c.EditV2(issue.Key, edr)
+		} else {
+			err = l.c.Edit(issue.Key, edr)
+		}
 		if err != nil {
-			return nil, err
+			return IssuePriorityChangedMsg{issueKey: issue.Key, priorities: priorities, err: err, stderr: err.Error()}
 		}
+		return IssuePriorityChangedMsg{issueKey: issue.Key, priority: next, priorities: priorities}
+	}
+}
+
+// clearParent detaches the issue from its parent, be it an epic or the parent of a subtask.
+func (l *IssueList) clearParent(issue *jira.Issue) tea.Cmd {
+	if issue.Fields.Parent == nil {
+		return l.setStatusMessage(fmt.Sprintf("%s has no parent", issue.Key))
+	}
+	parent := issue.Fields.Parent.Key
+
+	return func() tea.Msg {
+		var err error
+		edr := &jira.EditRequest{ParentIssueKey: jira.AssigneeNone}
+		if viper.GetString("installation") == jira.InstallationTypeLocal {
+			err = l.c.EditV2(issue.Key, edr)
+		} else {
+			err = l.c.Edit(issue.Key, edr)
+		}
+		if err != nil {
+			return IssueParentClearedMsg{issueKey: issue.Key, err: err, stderr: err.Error()}
+		}
+		return IssueParentClearedMsg{issueKey: issue.Key, parentKey: parent}
+	}
+}
+
+// isMutatingKey tells if the key triggers an action that modifies issues.
+func isMutatingKey(key string) bool {
+	switch key {
+	case "a", "A", "R", "alt+a", "ctrl+p", "X", "m", "e", "r", "T", "n", "c", "b", "p", "t":
+		return true
 	}
-	return l.cachedAllUsers, nil
+	return false
+}
+
+// isIssueKey tells if the key triggers an action on the issue under the cursor.
+func isIssueKey(key string) bool {
+	switch key {
+	case "a", "A", "R", "alt+a", "X", "m", "e", "r", "T", "c", "p", "b", "t", "y", "f":
+		return true
+	}
+	return false
+}
+
+// issueError shows why the issue under the cursor couldn't be acted upon,
+// keeping the session alive.
+func (l *IssueList) issueError(err error) (tea.Model, tea.Cmd) {
+	if errors.Is(err, errNoIssueSelected) {
+		return l, l.setStatusMessage("No issue selected")
+	}
+	return l.processError(err, "")
 }
 
 // Update handles user input and updates the model state.
 func (l *IssueList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m, cmd := l.update(msg)
+	if m == l {
+		cmd = tea.Batch(cmd, l.resumeAutoRefresh())
+	}
+	return m, cmd
+}
+
+func (l *IssueList) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 
 	switch msg := msg.(type) {
+	case AutoRefreshMsg:
+		return l, l.handleAutoRefresh(msg)
 	case tea.WindowSizeMsg:
 		// Store the full window size
 		l.rawWidth = msg.Width
 		l.rawHeight = msg.Height
 
-		// Reserve 2 rows for tabs only if there are multiple tabs
-		tabHeight := 0
-		if len(l.tabs) > 1 {
-			tabHeight = 2
+		tabHeight := l.tabBarHeight()
+		l.tableHeight = int(l.getSplitRatio() * float64(l.rawHeight-tabHeight))
+		if l.detailPaneHidden {
+			l.tableHeight = l.rawHeight - tabHeight
 		}
-		l.tableHeight = int(0.4 * float32(l.rawHeight-tabHeight))
-		l.previewHeight = l.rawHeight - l.tableHeight - tabHeight
+		l.previewHeight = max(l.rawHeight-l.tableHeight-tabHeight, 0)
 
 		var cmds []tea.Cmd
 
@@ -447,16 +778,61 @@ func (l *IssueList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m, _ := l.issueDetailViews[msg.index].Update(msg.issue)
 		l.tables[msg.index], cmd = l.tables[msg.index].Update(msg.issue)
 		l.issueDetailViews[msg.index] = m
-		return l, cmd
+		return l, tea.Batch(cmd, resolveMentions(l.c, msg.index, msg.issue))
+	case MentionsResolvedMsg:
+		if v := &l.issueDetailViews[msg.index]; v.Data != nil && v.Data.Key == msg.issueKey {
+			v.rerender()
+		}
+		return l, nil
+	case IssueFetchErrorMsg:
+		return l, l.setStatusMessage(fmt.Sprintf("Unable to load %s: %s", msg.issueKey, msg.err))
+	case MoreIssuesMsg:
+		return l, l.appendIssues(msg)
+	case ParentSummariesMsg:
+		if t := l.tables[msg.index]; t != nil {
+			if t.parentSummaries == nil {
+				t.parentSummaries = make(map[string]string)
+			}
+			maps.Copy(t.parentSummaries, msg.summaries)
+		}
+		return l, nil
 	case IncomingIssueListMsg:
 		var cmd tea.Cmd
 		thisTable := l.tables[msg.index]
 
+		if msg.err != nil {
+			// Keep listing what was there before the failed refresh.
+			previous := msg.previous
+			if previous == nil {
+				previous = []*jira.Issue{}
+			}
+			thisTable.SetIssueData(previous)
+			thisTable.selectKey(msg.cursorKey)
+			return l.processError(fmt.Errorf("unable to load the issues of %s: %w", l.tabs[msg.index].Name, msg.err), "")
+		}
+
 		thisTable.SetIssueData(msg.issues)
+		thisTable.total = msg.total
 		thisTable.SetBoardStateResolver(msg.resolver)
+		thisTable.selectKey(msg.cursorKey)
 
 		if len(msg.issues) > 0 {
-			cmd = thisTable.GetIssueAsync(msg.index, 0)
+			cmd = tea.Batch(thisTable.GetIssueAsync(msg.index, 0), thisTable.fetchParentSummaries(msg.index))
+			thisTable.PrefetchAround(0)
+		}
+
+		if msg.boardErr != nil && !l.tabs[msg.index].boardErrShown {
+			l.tabs[msg.index].boardErrShown = true
+			cmd = tea.Batch(cmd, l.setStatusMessage(msg.boardErr.Error()))
+		}
+
+		if msg.previous != nil && msg.issues != nil {
+			if summary := refreshSummary(msg.previous, msg.issues); summary != "" {
+				if msg.index != l.activeTab {
+					summary = fmt.Sprintf("%s: %s", l.tabs[msg.index].Name, summary)
+				}
+				cmd = tea.Batch(cmd, l.setStatusMessage(summary))
+			}
 		}
 		return l, cmd
 	// Can't combine the next 4 into one switch clause due to Go's type system
@@ -465,11 +841,70 @@ func (l *IssueList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return l.processError(msg.err, msg.stderr)
 		}
 		return l, l.reinitOnlyOneIssue(l.activeTab, msg.issueKey)
+	case AssignedToMeMsg:
+		if msg.me != nil {
+			l.cachedMe = msg.me
+		}
+		if msg.err != nil {
+			return l.processError(msg.err, msg.stderr)
+		}
+		return l, tea.Batch(
+			l.setStatusMessage(fmt.Sprintf("%s assigned to you", msg.issueKey)),
+			l.reinitOnlyOneIssue(l.activeTab, msg.issueKey),
+		)
+	case ViewExportedMsg:
+		if msg.err != nil {
+			return l, l.setStatusMessage(fmt.Sprintf("Unable to export the view: %s", msg.err))
+		}
+		return l, l.setStatusMessage(fmt.Sprintf("Exported %d issue(s) to %s", msg.issues, msg.path))
+	case CommentAddedMsg:
+		if msg.err != nil {
+			return l.processError(msg.err, msg.stderr)
+		}
+		return l, tea.Batch(
+			l.setStatusMessage(fmt.Sprintf("Comment added to %s", msg.issueKey)),
+			l.reinitOnlyOneIssue(msg.index, msg.issueKey),
+		)
+	case IssueReassignedMsg:
+		if msg.err != nil {
+			return l.processError(msg.err, msg.stderr)
+		}
+		if msg.unchanged {
+			return l, l.setStatusMessage(reassignedStatus(msg))
+		}
+		return l, tea.Batch(
+			l.setStatusMessage(reassignedStatus(msg)),
+			l.reinitOnlyOneIssue(msg.index, msg.issueKey),
+		)
 	case IssueMovedMsg:
 		if msg.err != nil {
 			return l.processError(msg.err, msg.stderr)
 		}
-		return l, l.reinitOnlyOneIssue(l.activeTab, msg.issueKey)
+		return l, tea.Batch(
+			l.setStatusMessage(fmt.Sprintf("%s moved to %s", msg.issueKey, msg.state)),
+			l.reinitOnlyOneIssue(l.activeTab, msg.issueKey),
+		)
+	case SummaryEnteredMsg:
+		return l, l.applySummary(msg)
+	case IssueRenamedMsg:
+		if msg.err != nil {
+			if msg.previous != "" {
+				l.tables[msg.index].setSummary(msg.issueKey, msg.previous)
+			}
+			return l.processError(msg.err, msg.stderr)
+		}
+		return l, l.reinitOnlyOneIssue(msg.index, msg.issueKey)
+	case LabelEnteredMsg:
+		return l, l.applyLabel(msg)
+	case IssuesLabeledMsg:
+		if msg.err != nil {
+			return l.processError(msg.err, msg.err.Error())
+		}
+		// Issues that failed stay marked, so the label can be retried on them.
+		for _, key := range msg.issueKeys {
+			delete(l.tables[msg.index].marked, key)
+		}
+		return l, tea.Batch(l.setStatusMessage(labeledStatus(msg)), l.reinitTable(msg.index))
 	case IssueAssignedToEpicMsg:
 		if msg.err != nil {
 			return l.processError(msg.err, msg.stderr)
@@ -485,6 +920,82 @@ func (l *IssueList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return l.processError(msg.err, msg.stderr)
 		}
 		return l, l.reinitOnlyOneIssue(l.activeTab, msg.issueKey)
+	case IssuePriorityChangedMsg:
+		if msg.priorities != nil {
+			l.cachedPriorities = msg.priorities
+		}
+		if msg.err != nil {
+			return l.processError(msg.err, msg.stderr)
+		}
+		if msg.priority == "" {
+			return l, l.setStatusMessage("No priorities configured on this Jira instance")
+		}
+		return l, tea.Batch(
+			l.setStatusMessage(fmt.Sprintf("Priority of %s set to %s", msg.issueKey, msg.priority)),
+			l.reinitOnlyOneIssue(l.activeTab, msg.issueKey),
+		)
+	case AssignableUsersMsg:
+		if msg.err != nil {
+			return l.processError(msg.err, "")
+		}
+		l.cachedAllUsers = msg.users
+		return l, nil
+	case ProjectsMsg:
+		if msg.err != nil {
+			return l.processError(msg.err, "")
+		}
+		l.cachedProjects = msg.projects
+		return l, nil
+	case TransitionsMsg:
+		if msg.err != nil {
+			return l.processError(msg.err, "")
+		}
+		return l, nil
+	case AttachmentsMsg:
+		if msg.err != nil {
+			return l.processError(msg.err, "")
+		}
+		if len(msg.attachments) == 0 {
+			return l, l.setStatusMessage(fmt.Sprintf("%s has no attachments", msg.issueKey))
+		}
+		return l, nil
+	case AttachmentDownloadedMsg:
+		if msg.err != nil {
+			return l.processError(msg.err, msg.err.Error())
+		}
+		return l, l.setStatusMessage(fmt.Sprintf("Downloaded %s to %s", msg.filename, msg.path))
+	case IssueTypesMsg:
+		if msg.err != nil {
+			return l.processError(msg.err, "")
+		}
+		if l.cachedIssueTypes == nil {
+			l.cachedIssueTypes = make(map[string][]*jira.IssueType)
+		}
+		l.cachedIssueTypes[msg.project] = msg.types
+		return l, nil
+	case IssueTypeChangedMsg:
+		if msg.err != nil {
+			return l.processError(msg.err, msg.stderr)
+		}
+		return l, tea.Batch(
+			l.setStatusMessage(fmt.Sprintf("%s is now a %s", msg.issueKey, msg.issueType)),
+			l.reinitOnlyOneIssue(l.activeTab, msg.issueKey),
+		)
+	case ResolutionsMsg:
+		if msg.err != nil {
+			l.pendingMove = nil
+			return l.processError(msg.err, "")
+		}
+		l.cachedResolutions = msg.resolutions
+		return l, nil
+	case IssueParentClearedMsg:
+		if msg.err != nil {
+			return l.processError(msg.err, msg.stderr)
+		}
+		return l, tea.Batch(
+			l.setStatusMessage(fmt.Sprintf("%s detached from %s", msg.issueKey, msg.parentKey)),
+			l.reinitOnlyOneIssue(l.activeTab, msg.issueKey),
+		)
 	case StatusClearMsg:
 		l.statusMessage = ""
 		if l.statusTimer != nil {
@@ -496,14 +1007,90 @@ func (l *IssueList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		switch msg.selectorType {
 		case FuzzySelectorEpic:
 			epic := msg.item.(*jira.Issue)
-			return l, l.assignToEpic(epic.Key, l.getCurrentTable().GetIssueSync(0))
+			issue, err := l.getCurrentTable().GetIssueSync(0)
+			if err != nil {
+				return l.issueError(err)
+			}
+			return l, l.assignToEpic(epic.Key, issue)
 		case FuzzySelectorUser:
 			user := msg.item.(*jira.User)
-			issue := l.getCurrentTable().GetIssueSync(0)
-			l.assignToUser(user, issue)
+			issue, err := l.getCurrentTable().GetIssueSync(0)
+			if err != nil {
+				return l.issueError(err)
+			}
+			if err := l.assignToUser(user, issue); err != nil {
+				return l.issueError(err)
+			}
 			return l, l.reinitOnlyOneIssue(l.activeTab, issue.Key)
+		case FuzzySelectorProject:
+			return l, l.switchProject(msg.item.(*jira.Project))
+		case FuzzySelectorQuery:
+			return l, l.openSavedQuery(msg.item.(config.SavedQuery))
+		case FuzzySelectorTransition:
+			tr := msg.item.(*jira.Transition)
+			issue, err := l.getCurrentTable().GetIssueSync(0)
+			if err != nil {
+				return l.issueError(err)
+			}
+			if tr.RequiresResolution() {
+				return l.selectResolution(issue, tr)
+			}
+			return l, l.transitionIssue(issue, tr, "")
+		case FuzzySelectorResolution:
+			if l.pendingMove == nil {
+				return l, nil
+			}
+			move := l.pendingMove
+			l.pendingMove = nil
+			return l, l.transitionIssue(move.issue, move.transition, msg.item.(resolutionItem).Name)
+		case FuzzySelectorIssueType:
+			issue, err := l.getCurrentTable().GetIssueSync(0)
+			if err != nil {
+				return l.issueError(err)
+			}
+			return l, l.changeIssueType(issue, msg.item.(*jira.IssueType).Name)
+		case FuzzySelectorCommentAuthor:
+			l.issueDetailViews[l.activeTab].filterCommentsByAuthor(msg.item.(commentAuthorItem).name)
+			return l, nil
+		case FuzzySelectorAttachment:
+			a := msg.item.(attachmentItem)
+			if msg.yank {
+				copyToClipboard(a.Content)
+				return l, l.setStatusMessage(fmt.Sprintf("Download URL of %s copied", a.Filename))
+			}
+			return l, tea.Batch(
+				l.setStatusMessage(fmt.Sprintf("Downloading %s...", a.Filename)),
+				l.downloadAttachment(a.Attachment),
+			)
+		case FuzzySelectorCopyComment:
+			c := msg.item.(quotableComment)
+			copyToClipboard(strings.TrimSpace(c.body))
+			return l, l.setStatusMessage(fmt.Sprintf("Comment by %s copied", c.author))
+		case FuzzySelectorComment:
+			c, _ := msg.item.(quotableComment)
+			var quote string
+			if c.author != "" {
+				quote = quoteComment(c)
+			}
+			issue, err := l.getCurrentTable().GetIssueSync(0)
+			if err != nil {
+				return l.issueError(err)
+			}
+			return l.addComment(issue, quote, c.internal)
+		}
+	case tea.MouseClickMsg:
+		if l.confirmingQuit || msg.Button != tea.MouseLeft {
+			return l, nil
 		}
+		return l, l.clickRow(msg.Y)
+	case tea.MouseWheelMsg:
+		l.issueDetailViews[l.activeTab], cmd = l.issueDetailViews[l.activeTab].Update(msg)
+		return l, cmd
 	case tea.KeyMsg:
+		if l.confirmingQuit {
+			return l, l.answerQuit(msg.String())
+		}
+
 		currentTable := l.getCurrentTable()
 		if currentTable != nil {
 			if currentTable.SorterState == SorterFiltering {
@@ -519,91 +1106,165 @@ func (l *IssueList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+		if l.readOnly && isMutatingKey(msg.String()) {
+			return l, l.setStatusMessage("Read-only mode: issues can't be modified")
+		}
+
+		var iss *jira.Issue
+		if isIssueKey(msg.String()) {
+			if currentTable == nil {
+				return l, nil
+			}
+			var err error
+			if iss, err = currentTable.GetIssueSync(0); err != nil {
+				return l.issueError(err)
+			}
+		}
+
+		if pos, ok := tabNumberKey(msg.String()); ok {
+			visible := l.visibleTabs()
+			if pos >= len(visible) {
+				return l, l.setStatusMessage(fmt.Sprintf("There is no tab %d", pos+1))
+			}
+			return l, l.selectTab(visible[pos])
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q", "esc":
-			return l, tea.Quit
+			return l, l.quit()
 		case "right", "l":
-			if len(l.tabs) > 1 {
-				l.activeTab = (l.activeTab + 1) % len(l.tabs)
-				tableSpinner := l.getCurrentTable().spinner.Tick
-				issueSpinner := l.getCurrentIssueDetailView().spinner.Tick
-				return l, tea.Batch(tableSpinner, issueSpinner)
-			}
+			return l, l.cycleTab(+1)
 		case "left", "h":
-			if len(l.tabs) > 1 {
-				l.activeTab = (l.activeTab - 1 + len(l.tabs)) % len(l.tabs)
-				tableSpinner := l.getCurrentTable().spinner.Tick
-				issueSpinner := l.getCurrentIssueDetailView().spinner.Tick
-				return l, tea.Batch(tableSpinner, issueSpinner)
-			}
+			return l, l.cycleTab(-1)
+		case "alt+right", "alt+l":
+			return l, l.moveTab(+1)
+		case "alt+left", "alt+h":
+			return l, l.moveTab(-1)
+		case "E":
+			return l, l.exportView()
+		case "alt+x":
+			return l, l.hideTab()
+		case "alt+u":
+			return l, l.showHiddenTabs()
 		case "up", "k":
 			currentTable := l.getCurrentTable()
 			var cmd1, cmd2 tea.Cmd
 			cmd1 = currentTable.GetIssueAsync(l.activeTab, -1)
+			currentTable.PrefetchAround(-1)
 			l.tables[l.activeTab], cmd = currentTable.Update(msg)
 			return l, tea.Batch(cmd1, cmd2)
 		case "down", "j":
 			currentTable := l.getCurrentTable()
 			var cmd1, cmd2 tea.Cmd
 			cmd1 = currentTable.GetIssueAsync(l.activeTab, +1)
+			currentTable.PrefetchAround(+1)
 			l.tables[l.activeTab], cmd = currentTable.Update(msg)
+			cmd2 = l.fetchMore(l.activeTab)
 			return l, tea.Batch(cmd1, cmd2)
 		case "a":
-			iss := l.getCurrentTable().GetIssueSync(0)
-			users, err := l.SafelyGetAssignableUsers(iss.Key)
-
-			if err != nil {
-				return l.processError(err, "")
-			}
-
-			listItems := []list.Item{}
-			for _, user := range users {
-				listItems = append(listItems, user)
-			}
-			fz := NewFuzzySelectorFrom(l, l.rawWidth, l.rawHeight, listItems, FuzzySelectorUser)
-			return fz, nil
+			return l.selectAssignee(iss)
+		case "A":
+			return l, l.assignToMe(iss)
+		case "R":
+			return l, l.assignToReporter(iss)
+		case "alt+a":
+			return l, l.assignToPreviousAssignee(iss)
 		case "ctrl+p":
 			// I hate golang, why tf []concrete -> []interface is invalid when concrete satisfies interface...
 			tabConfig := l.getCurrentTabConfig()
-			epics, _ := tabConfig.FetchEpics()
+			epics, _, err := tabConfig.FetchEpics()
+			if err != nil {
+				return l.issueError(err)
+			}
 			listItems := []list.Item{}
 			for _, epic := range epics {
 				listItems = append(listItems, epic)
 			}
 			fz := NewFuzzySelectorFrom(l, l.rawWidth, l.rawHeight, listItems, FuzzySelectorEpic)
 			return fz, nil
+		case "P":
+			return l.selectProject()
+		case "s":
+			return l.selectSavedQuery()
+		case "X":
+			return l, l.clearParent(iss)
 		case "m":
-			return l, l.moveIssue(l.getCurrentTable().GetIssueSync(0))
+			return l.selectTransition(iss)
 		case "e":
-			return l, l.editIssue(l.getCurrentTable().GetIssueSync(0))
+			return l, l.editIssue(iss)
+		case "r":
+			return l, l.renameIssue(iss)
+		case "x":
+			return l, l.toggleMark()
+		case "t":
+			return l, l.labelIssues(iss)
+		case "T":
+			return l.selectIssueType(iss)
 		case "u":
 			key := l.getCurrentTable().getKeyUnderCursorWithShift(0)
 			url := fmt.Sprintf("%s/browse/%s", l.Server, key)
 			copyToClipboard(url)
 			return l, l.setStatusMessage(fmt.Sprintf("Current issue FQDN copied: %s", url))
+		case "ctrl+k":
+			key := l.getCurrentTable().getKeyUnderCursorWithShift(0)
+			copyToClipboard(key)
+			return l, l.setStatusMessage(fmt.Sprintf("Current issue key copied: %s", key))
 		case "enter":
-			iss := l.getCurrentTable().GetIssueSync(0)
-			cmdutil.Navigate(l.Server, iss.Key)
-			return l, nil
+			if currentTable == nil {
+				return l, nil
+			}
+			// Opening only needs the key, not the fetched issue.
+			key := currentTable.getKeyUnderCursorWithShift(0)
+			if key == "" {
+				return l, l.setStatusMessage("No issue selected")
+			}
+			_ = history.Add(key)
+			return l, l.openIssue(key)
 		case "n":
 			return l, l.createIssue(l.getCurrentTabConfig().Project)
 		case "c":
-			return l, l.addComment(l.getCurrentTable().GetIssueSync(0))
+			return l.selectCommentToQuote(iss)
+		case "y":
+			return l.selectCommentToCopy(iss)
+		case "f":
+			return l.selectAttachment(iss)
+		case "p":
+			return l, l.cyclePriority(iss)
 		case "b":
-			return l, l.toggleBacklogState(l.getCurrentTable().GetIssueSync(0))
+			return l, l.toggleBacklogState(iss)
 		case "ctrl+r":
 			return l, l.reinitTable(l.activeTab)
 		case "?":
 			helpView := NewHelpView(l, l.rawWidth, l.rawHeight)
 			return helpView, nil
+		case "F":
+			return l.selectCommentAuthor()
+		case "M":
+			return NewLogView(l, l.statusLog, l.rawWidth, l.rawHeight), nil
+		case "D":
+			l.detailPaneHidden = !l.detailPaneHidden
+			width, height := l.rawWidth, l.rawHeight
+			return l, func() tea.Msg { return tea.WindowSizeMsg{Width: width, Height: height} }
+		case "+", "ctrl+down":
+			return l, l.resizeSplit(splitRatioStep)
+		case "-", "ctrl+up":
+			return l, l.resizeSplit(-splitRatioStep)
+		case " ", "space":
+			iss := l.getCurrentIssueDetailView().Data
+			if iss == nil {
+				return l, l.setStatusMessage("The issue is still loading")
+			}
+			return NewPeekView(l, l.Server, iss, l.rawWidth, l.rawHeight), nil
+		case "1", "2", "3", "4", "5", "6", "7", "8", "9", "0":
+			return l, l.applyQuickFilter(quickFilterIndex(msg.String()))
 
 		// Forwarding to issue:
-		case "ctrl+e", "ctrl+y", "tab":
+		case "ctrl+e", "ctrl+y", "tab", "shift+tab", "C", "L":
 			m, cmd := l.getCurrentIssueDetailView().Update(msg)
 			l.issueDetailViews[l.activeTab] = m
 			return l, cmd
 		// Forwarding straight to table:
-		case "/":
+		case "/", "<", ">":
 			l.tables[l.activeTab], cmd = l.getCurrentTable().Update(msg)
 		}
 	}
@@ -625,8 +1286,12 @@ func (l *IssueList) View() string {
 	currentView := l.getCurrentIssueDetailView()
 
 	// Update footer text based on status message
-	if l.statusMessage != "" {
+	if l.confirmingQuit {
+		currentTable.SetFooterText(quitPrompt)
+	} else if l.statusMessage != "" {
 		currentTable.SetFooterText(l.statusMessage)
+	} else if qf, ok := l.activeQuickFilter(l.activeTab); ok {
+		currentTable.SetFooterText(fmt.Sprintf("Quick filter: %s (0 to clear)", qf.Name))
 	} else {
 		currentTable.SetDefaultFooterText()
 	}
@@ -640,8 +1305,15 @@ func (l *IssueList) View() string {
 		Foreground(lipgloss.Color(getPaleColor())).
 		Render(strings.Repeat("─", l.rawWidth))
 
+	if l.detailPaneHidden {
+		if l.tabBarHeight() > 0 {
+			return lipgloss.JoinVertical(lipgloss.Left, l.renderTabs(), tableView)
+		}
+		return tableView
+	}
+
 	// Only render tabs if there's more than one
-	if len(l.tabs) > 1 {
+	if l.tabBarHeight() > 0 {
 		tabView := l.renderTabs()
 		// Join everything vertically with tabs
 		return lipgloss.JoinVertical(
@@ -686,10 +1358,14 @@ func (l *IssueList) renderTabs() string {
 	if len(l.tabs) == 0 {
 		return ""
 	}
+	if getCompactTabs() {
+		return l.renderCompactTabs()
+	}
 
 	var renderedTabs []string
 
-	for i, tabConfig := range l.tabs {
+	for _, i := range l.visibleTabs() {
+		tabConfig := l.tabs[i]
 		var style lipgloss.Style
 		isActive := i == l.activeTab
 		if isActive {
@@ -705,6 +1381,23 @@ func (l *IssueList) renderTabs() string {
 	return lipgloss.JoinHorizontal(lipgloss.Top, renderedTabs...)
 }
 
+// openIssue opens the issue in the browser. The URL is copied to the clipboard
+// instead if `ui.open_action` is "copy" or no browser is available, e.g. in an SSH session.
+func (l *IssueList) openIssue(key string) tea.Cmd {
+	url := cmdutil.GenerateServerBrowseURL(l.Server, key)
+
+	if viper.GetString("ui.open_action") != "copy" {
+		if cmdutil.BrowserAvailable() && cmdutil.Navigate(l.Server, key) == nil {
+			return nil
+		}
+		copyToClipboard(url)
+		return l.setStatusMessage(fmt.Sprintf("No browser available, URL copied: %s", url))
+	}
+
+	copyToClipboard(url)
+	return l.setStatusMessage(fmt.Sprintf("Current issue FQDN copied: %s", url))
+}
+
 // copyToClipboard copies text to clipboard.
 func copyToClipboard(text string) {
 	_ = clipboard.WriteAll(text)
@@ -0,0 +1,515 @@
+package bubble
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/v2/list"
+	"github.com/charmbracelet/bubbles/v2/table"
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/jira-tui/internal/exp"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+func TestRefreshSummary(t *testing.T) {
+	issues := func(keys ...string) []*jira.Issue {
+		out := make([]*jira.Issue, 0, len(keys))
+		for _, k := range keys {
+			out = append(out, &jira.Issue{Key: k})
+		}
+		return out
+	}
+
+	assert.Equal(t, "", refreshSummary(issues("A-1", "A-2"), issues("A-2", "A-1")))
+	assert.Equal(t, "2 new", refreshSummary(issues("A-1"), issues("A-3", "A-1", "A-2")))
+	assert.Equal(t, "1 removed", refreshSummary(issues("A-1", "A-2"), issues("A-2")))
+	assert.Equal(t, "3 new, 1 removed", refreshSummary(issues("A-1", "A-2"), issues("A-2", "A-3", "A-4", "A-5")))
+}
+
+func TestAssigneeSelectorLoadsUsers(t *testing.T) {
+	l := &IssueList{}
+	fz := NewFuzzySelectorFrom(l, 80, 24, nil, FuzzySelectorUser)
+
+	users := []*jira.User{{Name: "Jane Doe"}, {Name: "John Doe"}}
+	m, _ := fz.Update(AssignableUsersMsg{users: users})
+
+	assert.Same(t, fz, m)
+	assert.Len(t, fz.list.Items(), 2)
+	assert.Equal(t, users, l.cachedAllUsers)
+}
+
+func TestTransitionAsksForResolution(t *testing.T) {
+	l := &IssueList{}
+	iss := &jira.Issue{Key: "TEST-1"}
+	done := &jira.Transition{
+		ID:     "31",
+		Name:   "Done",
+		Fields: map[string]jira.TransitionField{"resolution": {Name: "Resolution", Required: true}},
+	}
+
+	m, _ := l.selectResolution(iss, done)
+	fz := m.(*FuzzySelector)
+	assert.Equal(t, "Resolution:", fz.list.Title)
+	assert.Equal(t, &pendingMove{issue: iss, transition: done}, l.pendingMove)
+
+	resolutions := []*jira.Resolution{{ID: "1", Name: "Done"}, {ID: "2", Name: "Won't Do"}}
+	m, _ = fz.Update(ResolutionsMsg{resolutions: resolutions})
+	assert.Same(t, fz, m)
+	assert.Len(t, fz.list.Items(), 2)
+	assert.Equal(t, resolutions, l.cachedResolutions)
+
+	m, cmd := l.Update(FuzzySelectorResultMsg{item: fz.list.Items()[1], selectorType: FuzzySelectorResolution})
+	assert.Same(t, l, m)
+	assert.NotNil(t, cmd)
+	assert.Nil(t, l.pendingMove)
+}
+
+func TestQuickFilterToggle(t *testing.T) {
+	tab := &TabConfig{
+		Name:          "Issues",
+		FetchIssues:   func() ([]*jira.Issue, int, error) { return nil, 0, nil },
+		FetchFiltered: func(string) ([]*jira.Issue, int, error) { return nil, 0, nil },
+	}
+	l := &IssueList{
+		tabs:             []*TabConfig{tab},
+		tables:           make([]*Table, 1),
+		issueDetailViews: make([]IssueModel, 1),
+	}
+
+	assert.NotNil(t, l.applyQuickFilter(quickFilterIndex("2")))
+	qf, ok := l.activeQuickFilter(0)
+	assert.True(t, ok)
+	assert.Equal(t, "Unassigned", qf.Name)
+
+	// the same key again clears the filter
+	l.applyQuickFilter(quickFilterIndex("2"))
+	_, ok = l.activeQuickFilter(0)
+	assert.False(t, ok)
+
+	l.applyQuickFilter(quickFilterIndex("1"))
+	l.applyQuickFilter(quickFilterIndex("0"))
+	_, ok = l.activeQuickFilter(0)
+	assert.False(t, ok)
+
+	tab.FetchFiltered = nil
+	l.applyQuickFilter(quickFilterIndex("1"))
+	_, ok = l.activeQuickFilter(0)
+	assert.False(t, ok)
+}
+
+func TestDetailPaneHidden(t *testing.T) {
+	l := &IssueList{tabs: []*TabConfig{{Name: "Issues"}}, detailPaneHidden: true}
+
+	l.update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	assert.Equal(t, 40, l.tableHeight)
+	assert.Zero(t, l.previewHeight)
+
+	l.detailPaneHidden = false
+	l.update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	assert.Equal(t, 16, l.tableHeight)
+	assert.Equal(t, 24, l.previewHeight)
+}
+
+func TestPeekViewCloses(t *testing.T) {
+	l := &IssueList{}
+	p := NewPeekView(l, "https://jira.example.com", &jira.Issue{Key: "TEST-1"}, 100, 50)
+	assert.Equal(t, 80, p.issue.RawWidth)
+	assert.Equal(t, 38, p.issue.RawHeight)
+
+	m, cmd := p.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	assert.Same(t, l, m)
+	assert.Equal(t, tea.WindowSizeMsg{Width: 100, Height: 50}, cmd())
+}
+
+func TestBoardErrorShownOnce(t *testing.T) {
+	l := &IssueList{
+		tabs:             []*TabConfig{{Name: "Issues", BoardId: 123}},
+		tables:           []*Table{NewTable()},
+		issueDetailViews: make([]IssueModel, 1),
+	}
+	boardErr := &exp.ErrBoardUnavailable{BoardID: 123}
+
+	l.update(IncomingIssueListMsg{index: 0, boardErr: boardErr})
+	assert.Equal(t, "board 123 not found or inaccessible — backlog highlighting disabled", l.statusMessage)
+
+	l.statusMessage = ""
+	l.update(IncomingIssueListMsg{index: 0, boardErr: boardErr})
+	assert.Empty(t, l.statusMessage)
+}
+
+func TestResizeSplit(t *testing.T) {
+	l := &IssueList{tabs: []*TabConfig{{Name: "Issues"}}, rawWidth: 120, rawHeight: 40}
+
+	assert.NotNil(t, l.resizeSplit(splitRatioStep))
+	assert.Equal(t, 0.45, l.splitRatio)
+	assert.True(t, l.splitResized)
+
+	l.update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	assert.Equal(t, 18, l.tableHeight)
+	assert.Equal(t, 22, l.previewHeight)
+
+	for range 20 {
+		l.resizeSplit(-splitRatioStep)
+	}
+	assert.Equal(t, minSplitRatio, l.splitRatio)
+	assert.Nil(t, l.resizeSplit(-splitRatioStep))
+
+	for range 20 {
+		l.resizeSplit(splitRatioStep)
+	}
+	assert.Equal(t, maxSplitRatio, l.splitRatio)
+}
+
+func TestConfirmQuit(t *testing.T) {
+	l := &IssueList{}
+	assert.NotNil(t, l.quit())
+	assert.False(t, l.confirmingQuit)
+
+	viper.Set("ui.confirm_quit", true)
+	t.Cleanup(func() { viper.Set("ui.confirm_quit", nil) })
+
+	assert.Nil(t, l.quit())
+	assert.True(t, l.confirmingQuit)
+	assert.Nil(t, l.answerQuit("n"))
+	assert.False(t, l.confirmingQuit)
+
+	l.quit()
+	assert.NotNil(t, l.answerQuit("y"))
+	assert.False(t, l.confirmingQuit)
+}
+
+type panickingModel struct{}
+
+func (panickingModel) Init() tea.Cmd                       { return nil }
+func (panickingModel) Update(tea.Msg) (tea.Model, tea.Cmd) { panic("boom") }
+func (panickingModel) View() string                        { return "" }
+
+func TestRecoverModelShowsPanic(t *testing.T) {
+	var m tea.Model = recoverModel{model: panickingModel{}}
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'x', Text: "x"})
+
+	errorModel, ok := m.(recoverModel).model.(ErrorModel)
+	assert.True(t, ok)
+	assert.Contains(t, errorModel.errorMessage, "boom")
+	assert.Equal(t, panickingModel{}, errorModel.parentModel)
+}
+
+func TestIssueActionOnEmptyTable(t *testing.T) {
+	l := &IssueList{tables: []*Table{{}}, tabs: []*TabConfig{{Name: "Issues"}}}
+
+	_, err := l.tables[0].GetIssueSync(0)
+	assert.ErrorIs(t, err, errNoIssueSelected)
+
+	m, _ := l.issueError(err)
+	assert.Same(t, l, m)
+	assert.Equal(t, "No issue selected", l.statusMessage)
+}
+
+func TestStatusLog(t *testing.T) {
+	log := &statusLog{}
+	for i := range statusLogSize + 5 {
+		log.add(fmt.Sprintf("message %d", i))
+	}
+
+	lines := log.lines()
+	assert.Len(t, lines, statusLogSize)
+	assert.True(t, strings.HasSuffix(lines[0], fmt.Sprintf("message %d", statusLogSize+4)))
+	assert.True(t, strings.HasSuffix(lines[len(lines)-1], "message 5"))
+
+	l := &IssueList{statusLog: log}
+	v := NewLogView(l, log, 80, 24)
+	m, _ := v.Update(tea.KeyPressMsg{Code: 'M', Text: "M"})
+	assert.Same(t, l, m)
+}
+
+func TestRenameUpdatesRow(t *testing.T) {
+	iss := &jira.Issue{Key: "TEST-1", Fields: jira.IssueFields{Summary: "Fix teh login"}}
+	l := &IssueList{tables: []*Table{{allIssues: []*jira.Issue{iss}}}, tabs: []*TabConfig{{Name: "Issues"}}}
+
+	assert.Nil(t, l.applySummary(SummaryEnteredMsg{issueKey: "TEST-1", previous: "Fix teh login", summary: "Fix teh login"}))
+
+	cmd := l.applySummary(SummaryEnteredMsg{issueKey: "TEST-1", previous: "Fix teh login", summary: "Fix the login"})
+	assert.NotNil(t, cmd)
+	assert.Equal(t, "Fix the login", iss.Fields.Summary)
+
+	m, _ := l.Update(IssueRenamedMsg{issueKey: "TEST-1", previous: "Fix teh login", err: assert.AnError, stderr: "boom"})
+	assert.IsType(t, ErrorModel{}, m)
+	assert.Equal(t, "Fix teh login", iss.Fields.Summary)
+}
+
+func TestIssueTypeSelectorLoadsTypes(t *testing.T) {
+	l := &IssueList{}
+	fz := NewFuzzySelectorFrom(l, 80, 24, nil, FuzzySelectorIssueType)
+
+	types := []*jira.IssueType{{Name: "Story"}, {Name: "Bug"}, {Name: "Sub-task", Subtask: true}}
+	m, _ := fz.Update(IssueTypesMsg{project: "TEST", current: jira.IssueType{Name: "Story"}, types: types})
+
+	assert.Same(t, fz, m)
+	assert.Equal(t, []list.Item{types[1]}, fz.list.Items())
+	assert.Equal(t, types, l.cachedIssueTypes["TEST"])
+}
+
+func TestBulkLabel(t *testing.T) {
+	issues := []*jira.Issue{
+		{Key: "TEST-1", Fields: jira.IssueFields{Labels: []string{"backend", "needs-triage"}}},
+		{Key: "TEST-2", Fields: jira.IssueFields{Labels: []string{"backend"}}},
+		{Key: "TEST-3"},
+	}
+	table := &Table{allIssues: issues, columns: []string{FieldKey}}
+	l := &IssueList{tables: []*Table{table}, tabs: []*TabConfig{{Name: "Issues"}}}
+
+	table.toggleMark("TEST-3")
+	table.toggleMark("TEST-1")
+	table.toggleMark("TEST-2")
+	table.toggleMark("TEST-2")
+	assert.Equal(t, []string{"TEST-1", "TEST-3"}, table.markedKeys())
+	assert.Equal(t, []string{markPrefix + "TEST-1"}, table.assignColumns(table.columns, issues[0]))
+
+	p := &labelPrompt{known: l.knownLabels()}
+	assert.Equal(t, []string{"backend", "needs-triage"}, p.known)
+	assert.Equal(t, []string{"needs-triage"}, p.suggest("Ne"))
+	assert.Equal(t, []string{"-backend"}, p.suggest("-b"))
+
+	assert.Nil(t, l.applyLabel(LabelEnteredMsg{issueKeys: []string{"TEST-1"}, label: "-"}))
+
+	assert.Equal(t, `Added label "triaged" on 2 issue(s)`,
+		labeledStatus(IssuesLabeledMsg{label: "triaged", issueKeys: []string{"TEST-1", "TEST-3"}}))
+	assert.Equal(t, `Removed label "backend" on 1 issue(s), 1 failed: TEST-3: boom`,
+		labeledStatus(IssuesLabeledMsg{label: "-backend", issueKeys: []string{"TEST-1"}, failed: []string{"TEST-3: boom"}}))
+}
+
+func TestCompactTabs(t *testing.T) {
+	t.Cleanup(viper.Reset)
+	viper.Set("ui.tabs.compact", true)
+
+	l := &IssueList{tabs: []*TabConfig{{Name: "Issues"}, {Name: "Bugs"}, {Name: "Review"}}, activeTab: 1}
+	assert.Equal(t, 1, l.tabBarHeight())
+	assert.Equal(t, " 1·Issues [2·Bugs] 3·Review", stripANSI(l.renderTabs()))
+
+	l.rawWidth = 12
+	assert.Equal(t, " 1·Issues [2", stripANSI(l.renderTabs()))
+
+	index, ok := tabNumberKey("alt+3")
+	assert.True(t, ok)
+	assert.Equal(t, 2, index)
+	_, ok = tabNumberKey("alt+0")
+	assert.False(t, ok)
+
+	// The plain digits are bound to the default quick filters.
+	_, ok = tabNumberKey("3")
+	assert.False(t, ok)
+	viper.Set("ui.quick_filters", []any{})
+	index, ok = tabNumberKey("3")
+	assert.True(t, ok)
+	assert.Equal(t, 2, index)
+}
+
+func TestTabLayout(t *testing.T) {
+	t.Cleanup(viper.Reset)
+	viper.Set("ui.tabs.compact", true)
+	viper.Set("ui.tabs.order", []string{"Review", "Issues"})
+	viper.Set("ui.tabs.hidden", []string{"Bugs"})
+
+	l := &IssueList{
+		tabs:             []*TabConfig{{Name: "Issues"}, {Name: "Bugs"}, {Name: "Review"}, {Name: "Recent"}},
+		tables:           []*Table{{}, {}, {}, {}},
+		issueDetailViews: make([]IssueModel, 4),
+		statusLog:        &statusLog{},
+	}
+	l.applyTabLayout()
+	assert.Equal(t, 2, l.activeTab)
+	assert.Equal(t, " [1·Review] 2·Issues 3·Recent", stripANSI(l.renderTabs()))
+
+	l.cycleTab(-1)
+	assert.Equal(t, 3, l.activeTab)
+
+	// The hidden tab is skipped over
+	l.moveTab(-1)
+	assert.Equal(t, " 1·Review [2·Recent] 3·Issues", stripANSI(l.renderTabs()))
+	l.moveTab(-1)
+	l.moveTab(-1)
+	assert.Equal(t, " [1·Recent] 2·Review 3·Issues", stripANSI(l.renderTabs()))
+
+	l.hideTab()
+	assert.Equal(t, 2, l.activeTab)
+	assert.Equal(t, " [1·Review] 2·Issues", stripANSI(l.renderTabs()))
+	l.hideTab()
+	assert.Equal(t, 0, l.activeTab)
+	assert.Equal(t, 0, l.tabBarHeight())
+	l.hideTab()
+	assert.Equal(t, "The last tab can't be hidden", l.statusMessage)
+
+	l.showHiddenTabs()
+	assert.Equal(t, " 1·Recent 2·Review 3·Bugs [4·Issues]", stripANSI(l.renderTabs()))
+
+	dir := t.TempDir()
+	cfg := filepath.Join(dir, ".config.yml")
+	assert.NoError(t, os.WriteFile(cfg, []byte("server: https://jira.example.com\n"), 0o600))
+	viper.SetConfigFile(cfg)
+
+	l.tabs[1].hidden = true
+	assert.NoError(t, l.saveTabLayout())
+
+	saved := viper.New()
+	saved.SetConfigFile(cfg)
+	assert.NoError(t, saved.ReadInConfig())
+	assert.Equal(t, []string{"Recent", "Review", "Bugs", "Issues"}, saved.GetStringSlice("ui.tabs.order"))
+	assert.Equal(t, []string{"Bugs"}, saved.GetStringSlice("ui.tabs.hidden"))
+}
+
+func TestClickRow(t *testing.T) {
+	f := issueFetcher{}
+	tbl := NewTable(WithFetcher(f))
+	tbl.SetColumns([]string{FieldKey, FieldSummary})
+	var issues []*jira.Issue
+	for n := 1; n <= 12; n++ {
+		iss := &jira.Issue{Key: fmt.Sprintf("TEST-%d", n)}
+		iss.Fields.Summary = fmt.Sprintf("Issue %d", n)
+		issues = append(issues, iss)
+		f[iss.Key] = iss
+	}
+	tbl.SetIssueData(issues)
+
+	l := &IssueList{
+		tabs:             []*TabConfig{{Name: "Issues"}},
+		tables:           []*Table{tbl},
+		issueDetailViews: make([]IssueModel, 1),
+	}
+	l.update(tea.WindowSizeMsg{Width: 120, Height: 40})
+
+	lineOf := func(key string) int {
+		for y, line := range strings.Split(tbl.View(), "\n") {
+			if strings.Contains(stripANSI(line), key+" ") {
+				return y
+			}
+		}
+		return -1
+	}
+
+	_, cmd := l.update(tea.MouseClickMsg{X: 5, Y: lineOf("TEST-7"), Button: tea.MouseLeft})
+	assert.NotNil(t, cmd)
+	assert.Equal(t, 6, tbl.GetCursorRow())
+	msg := tbl.GetIssueAsync(0, 0)()
+	assert.Equal(t, "TEST-7", msg.(IncomingIssueMsg).issue.Key)
+
+	_, cmd = l.update(tea.MouseClickMsg{X: 5, Y: lineOf("TEST-2"), Button: tea.MouseLeft})
+	assert.NotNil(t, cmd)
+	assert.Equal(t, 1, tbl.GetCursorRow())
+
+	// Clicks outside of the rows and with other buttons are ignored
+	_, cmd = l.update(tea.MouseClickMsg{X: 5, Y: 0, Button: tea.MouseLeft})
+	assert.Nil(t, cmd)
+	_, cmd = l.update(tea.MouseClickMsg{X: 5, Y: lineOf("TEST-3"), Button: tea.MouseRight})
+	assert.Nil(t, cmd)
+	assert.Equal(t, 1, tbl.GetCursorRow())
+}
+
+func TestAssignToReporter(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue/TEST-1/assignee", r.URL.Path)
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	l := &IssueList{c: jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))}
+
+	iss := &jira.Issue{Key: "TEST-1"}
+	assert.NotNil(t, l.assignToReporter(iss))
+	assert.Empty(t, body)
+	assert.Contains(t, l.statusMessage, "TEST-1 has no reporter")
+
+	iss.Fields.Reporter.AccountID = "r1"
+	iss.Fields.Reporter.Name = "Rita Reporter"
+	msg := l.assignToReporter(iss)()
+	assert.JSONEq(t, `{"accountId": "r1"}`, body)
+	assert.Equal(t, "TEST-1 assigned to Rita Reporter", reassignedStatus(msg.(IssueReassignedMsg)))
+
+	assert.Equal(t, "TEST-1 unassigned", reassignedStatus(IssueReassignedMsg{issueKey: "TEST-1"}))
+	assert.Equal(t, "TEST-1 was never reassigned", reassignedStatus(IssueReassignedMsg{issueKey: "TEST-1", unchanged: true}))
+}
+
+func TestSelectCommentToQuote(t *testing.T) {
+	l := &IssueList{
+		tabs:             []*TabConfig{{Name: "Issues"}},
+		issueDetailViews: make([]IssueModel, 1),
+		rawWidth:         120,
+		rawHeight:        40,
+	}
+	iss := &jira.Issue{Key: "TEST-1"}
+
+	// nothing to quote, but an internal comment can still be picked
+	m, _ := l.selectCommentToQuote(iss)
+	assert.IsType(t, &FuzzySelector{}, m)
+
+	// a reply quoting an internal comment stays internal
+	public := false
+	iss.Fields.Comment.Comments = []jira.Comment{{Body: "Looks good"}, {Body: "Agents only", JSDPublic: &public}}
+	items := l.quotableComments(iss)
+	assert.Len(t, items, 4)
+	assert.True(t, items[2].internal)
+	assert.False(t, items[3].internal)
+
+	tbl := NewTable()
+	tbl.SetIssueData([]*jira.Issue{iss})
+	tbl.cacheIssue(iss.Key, iss)
+	l.tables = []*Table{tbl}
+	m, _ = l.update(FuzzySelectorResultMsg{item: items[2], selectorType: FuzzySelectorComment})
+	assert.True(t, m.(*CommentPane).internal)
+}
+
+func TestFetchMore(t *testing.T) {
+	page := func(from, n int) []*jira.Issue {
+		issues := make([]*jira.Issue, 0, n)
+		for i := from; i < from+n; i++ {
+			issues = append(issues, &jira.Issue{Key: fmt.Sprintf("TEST-%d", i+1)})
+		}
+		return issues
+	}
+	var froms []uint
+	tab := &TabConfig{
+		Name: "Issues",
+		FetchMore: func(jql string, from uint) ([]*jira.Issue, error) {
+			froms = append(froms, from)
+			return page(int(from), 30), nil
+		},
+	}
+	tbl := NewTable()
+	tbl.SetIssueData(page(0, 100))
+	tbl.total = 130
+	l := &IssueList{tabs: []*TabConfig{tab}, tables: []*Table{tbl}}
+
+	// the cursor is far from the end
+	assert.Nil(t, l.fetchMore(0))
+
+	tbl.table.SetRows(make([]table.Row, 100))
+	tbl.table.SetCursor(85)
+	cmd := l.fetchMore(0)
+	assert.NotNil(t, cmd)
+	assert.Nil(t, l.fetchMore(0), "a page is already on the way")
+
+	l.update(cmd())
+	assert.Equal(t, []uint{100}, froms)
+	assert.Len(t, tbl.allIssues, 130)
+	assert.Nil(t, l.fetchMore(0), "every issue is listed")
+
+	// a page fetched for a table replaced by a refresh is dropped
+	l.tables[0] = NewTable()
+	l.update(MoreIssuesMsg{index: 0, issues: page(130, 10), table: tbl})
+	assert.Len(t, tbl.allIssues, 130)
+	assert.Nil(t, l.tables[0].allIssues)
+}
@@ -0,0 +1,80 @@
+package bubble
+
+import (
+	"errors"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+)
+
+// snapshotTimeout bounds the time to wait for the issues to load.
+const snapshotTimeout = time.Minute
+
+// Snapshot renders a single frame of the list, width x height cells, once the
+// issues of the first tab and the details of the first issue are loaded. The
+// model is driven without a program, so nothing is read from or written to the
+// terminal.
+func Snapshot(project, server string, total int, tabs []*TabConfig, debugMode, readOnly bool, width, height int) (string, error) {
+	// There is no terminal to ask for its background color.
+	if currentTheme == "" {
+		currentTheme = "dark"
+	}
+
+	l := newIssueList(project, server, total, tabs, debugMode, readOnly)
+	msgs := make(chan tea.Msg)
+
+	// Commands run in the background like the program would run them, ticks
+	// that never fire before the frame is ready are just left behind.
+	var run func(cmd tea.Cmd)
+	run = func(cmd tea.Cmd) {
+		if cmd == nil {
+			return
+		}
+		go func() {
+			msg := cmd()
+			if batch, ok := msg.(tea.BatchMsg); ok {
+				for _, c := range batch {
+					run(c)
+				}
+				return
+			}
+			msgs <- msg
+		}()
+	}
+
+	update := func(msg tea.Msg) {
+		_, cmd := l.Update(msg)
+		run(cmd)
+	}
+
+	run(l.Init())
+	update(tea.WindowSizeMsg{Width: width, Height: height})
+
+	timeout := time.After(snapshotTimeout)
+	for !l.snapshotReady() {
+		select {
+		case msg := <-msgs:
+			if _, ok := msg.(tea.QuitMsg); ok {
+				return "", errors.New("the list was closed before it was rendered")
+			}
+			update(msg)
+		case <-timeout:
+			return "", errors.New("timed out waiting for the issues to load")
+		}
+	}
+
+	return l.View(), nil
+}
+
+// snapshotReady tells if the active tab has its issues and, unless the detail
+// pane is hidden or there is nothing to show in it, the issue under the cursor.
+func (l *IssueList) snapshotReady() bool {
+	table := l.tables[l.activeTab]
+	if table == nil || table.allIssues == nil {
+		return false
+	}
+	if len(table.allIssues) == 0 || l.detailPaneHidden {
+		return true
+	}
+	return l.issueDetailViews[l.activeTab].Data != nil
+}
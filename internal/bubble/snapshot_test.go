@@ -0,0 +1,21 @@
+package bubble
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+func TestSnapshot(t *testing.T) {
+	tabs := []*TabConfig{{
+		Name:        "Issues",
+		FetchIssues: func() ([]*jira.Issue, int, error) { return []*jira.Issue{}, 0, nil },
+	}}
+
+	frame, err := Snapshot("TEST", "https://jira.example.com", 0, tabs, false, true, 80, 20)
+	require.NoError(t, err)
+	assert.Contains(t, frame, "No issues found")
+}
@@ -0,0 +1,69 @@
+package bubble
+
+import (
+	"math"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/internal/config"
+)
+
+const (
+	splitRatioKey     = "ui.list.split_ratio"
+	defaultSplitRatio = 0.4
+	minSplitRatio     = 0.2
+	maxSplitRatio     = 0.8
+	splitRatioStep    = 0.05
+)
+
+// getSplitRatio reads `ui.list.split_ratio`, the share of the screen taken by
+// the list when the detail pane is shown.
+func getSplitRatio() float64 {
+	if !viper.IsSet(splitRatioKey) {
+		return defaultSplitRatio
+	}
+	return clampSplitRatio(viper.GetFloat64(splitRatioKey))
+}
+
+func clampSplitRatio(ratio float64) float64 {
+	// Rounded, so that repeated steps don't drift away from the clamp bounds.
+	ratio = math.Round(ratio*100) / 100
+	return min(max(ratio, minSplitRatio), maxSplitRatio)
+}
+
+// resizeSplit grows the list by delta of the screen height, shrinking the
+// detail pane. The new ratio is saved to the config on quit.
+func (l *IssueList) resizeSplit(delta float64) tea.Cmd {
+	if l.detailPaneHidden {
+		return l.setStatusMessage("The detail pane is hidden, press D to show it")
+	}
+
+	ratio := clampSplitRatio(l.getSplitRatio() + delta)
+	if ratio == l.splitRatio {
+		return nil
+	}
+	l.splitRatio = ratio
+	l.splitResized = true
+
+	width, height := l.rawWidth, l.rawHeight
+	return func() tea.Msg { return tea.WindowSizeMsg{Width: width, Height: height} }
+}
+
+// getSplitRatio returns the split ratio of the list, the configured one until
+// it is changed.
+func (l *IssueList) getSplitRatio() float64 {
+	if l.splitRatio == 0 {
+		l.splitRatio = getSplitRatio()
+	}
+	return l.splitRatio
+}
+
+// saveSplitRatio saves the split ratio to the config, if it was changed during
+// the session.
+func (l *IssueList) saveSplitRatio() error {
+	if !l.splitResized {
+		return nil
+	}
+	return config.Set(splitRatioKey, l.splitRatio)
+}
@@ -0,0 +1,124 @@
+package bubble
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/charmbracelet/lipgloss/v2"
+)
+
+// statusLogSize is the number of status messages kept for the log panel.
+const statusLogSize = 100
+
+type statusLogEntry struct {
+	at      time.Time
+	message string
+}
+
+// statusLog keeps the last status messages, so that the ones flashing by in the
+// footer can be read later. Background requests write to it too, hence the mutex.
+type statusLog struct {
+	mu      sync.Mutex
+	entries []statusLogEntry
+}
+
+func (s *statusLog) add(message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries = append(s.entries, statusLogEntry{at: time.Now(), message: message})
+	if len(s.entries) > statusLogSize {
+		s.entries = s.entries[len(s.entries)-statusLogSize:]
+	}
+}
+
+// lines returns the messages with their time, newest first.
+func (s *statusLog) lines() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make([]string, 0, len(s.entries))
+	for i := len(s.entries) - 1; i >= 0; i-- {
+		e := s.entries[i]
+		out = append(out, fmt.Sprintf("%s  %s", e.at.Format("15:04:05"), e.message))
+	}
+	return out
+}
+
+// isLogKey tells if the key opens or closes the log panel.
+func isLogKey(key string) bool {
+	return key == "M"
+}
+
+// LogView shows the recent status messages in a popup over the list.
+type LogView struct {
+	RawWidth  int
+	RawHeight int
+
+	lines            []string
+	firstVisibleLine int
+
+	PreviousModel tea.Model
+}
+
+// NewLogView creates a popup with the messages of the log.
+func NewLogView(prev tea.Model, log *statusLog, width, height int) *LogView {
+	return &LogView{
+		PreviousModel: prev,
+		RawWidth:      width,
+		RawHeight:     height,
+		lines:         log.lines(),
+	}
+}
+
+// contentHeight is the number of lines fitting into the popup, it takes 80%
+// of the screen and the border takes 2 more rows.
+func (v *LogView) contentHeight() int {
+	return max(int(float32(v.RawHeight)*0.8)-2, 1)
+}
+
+func (v *LogView) Init() tea.Cmd {
+	return nil
+}
+
+func (v *LogView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		v.RawWidth = msg.Width
+		v.RawHeight = msg.Height
+	case tea.KeyMsg:
+		switch key := msg.String(); {
+		case isLogKey(key), key == "esc", key == "q":
+			return v.PreviousModel, func() tea.Msg {
+				return tea.WindowSizeMsg{Width: v.RawWidth, Height: v.RawHeight}
+			}
+		case key == "j", key == "down":
+			v.firstVisibleLine = min(v.firstVisibleLine+1, max(len(v.lines)-v.contentHeight(), 0))
+		case key == "k", key == "up":
+			v.firstVisibleLine = max(v.firstVisibleLine-1, 0)
+		}
+	}
+	return v, nil
+}
+
+func (v *LogView) View() string {
+	width := int(float32(v.RawWidth) * 0.8)
+
+	content := "No messages yet"
+	if len(v.lines) > 0 {
+		end := min(v.firstVisibleLine+v.contentHeight(), len(v.lines))
+		content = strings.Join(v.lines[v.firstVisibleLine:end], "\n")
+	}
+
+	popup := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color(getAccentColor())).
+		Width(width).
+		Height(v.contentHeight()).
+		Render(content)
+
+	return lipgloss.Place(v.RawWidth, v.RawHeight, lipgloss.Center, lipgloss.Center, popup)
+}
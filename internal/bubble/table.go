@@ -1,15 +1,21 @@
 package bubble
 
 import (
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 
+	"github.com/charmbracelet/bubbles/v2/list"
 	"github.com/charmbracelet/bubbles/v2/spinner"
 	"github.com/charmbracelet/bubbles/v2/table"
 	tea "github.com/charmbracelet/bubbletea/v2"
 	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/spf13/viper"
+
 	"github.com/jorres/jira-tui/api"
+	"github.com/jorres/jira-tui/internal/cmdutil"
 	"github.com/jorres/jira-tui/internal/debug"
 	"github.com/jorres/jira-tui/internal/exp"
 	"github.com/jorres/jira-tui/pkg/jira"
@@ -18,6 +24,12 @@ import (
 
 var _ = debug.Debug
 
+// errNoIssueSelected is returned when an action needs an issue but the table is empty.
+var errNoIssueSelected = errors.New("no issue selected")
+
+// errNoFetcher is returned when the table has nowhere to fetch issues from.
+var errNoFetcher = errors.New("no issue fetcher configured")
+
 const (
 	SorterInactive int = iota
 	SorterFiltering
@@ -25,7 +37,10 @@ const (
 )
 
 const (
-	sorterHeight = 3
+	sorterHeight   = 3
+	minColumnWidth = 10
+
+	defaultSummaryMaxWidth = 150
 )
 
 // TableData is the data to be displayed in a table.
@@ -56,12 +71,47 @@ type Table struct {
 
 	err error
 
-	columns  []string
-	timezone string
-
-	allIssues      []*jira.Issue
+	columns []string
+	// customColumns maps the header of custom field columns to the field id.
+	customColumns map[string]string
+	columnWeights map[string]int
+	// columnFormats is the format picked for each column, see columnformat.go
+	columnFormats map[string]string
+	timezone      string
+
+	// summaryMaxWidth lets SUMMARY grow past its share up to this width, 0 disables it
+	summaryMaxWidth int
+
+	// linkServer is the server issue keys link to, empty if they aren't linked
+	linkServer string
+
+	// columnOffset is the number of columns scrolled out on the left when
+	// not all of them fit, see visibleColumns
+	columnOffset int
+
+	allIssues []*jira.Issue
+	// total is the number of issues matching the query of the tab, more than
+	// allIssues if the tab is loaded page by page, see paging.go
+	total          int
+	fetchingMore   bool
 	filteredIssues []*jira.Issue
 	issueCache     map[string]*jira.Issue
+	cacheMu        sync.Mutex
+	prefetcher     *prefetcher
+	// fetcher loads the details of the issues, nil disables fetching
+	fetcher api.Fetcher
+	// statusLog collects failures of background requests, nil if not shown
+	statusLog *statusLog
+
+	// filterMatches are where the filter matched the summary of each filtered issue
+	filterMatches map[string]filterMatch
+	// summaryWidth is the width of the SUMMARY column as last rendered, 0 if hidden
+	summaryWidth int
+	// parentSummaries holds the summaries of parents not included in the search
+	// results, keyed by the parent key, see parent.go
+	parentSummaries map[string]string
+	// marked are the keys of the issues marked for a bulk action, see labels.go
+	marked map[string]bool
 
 	// Data provider for getting table data
 	dataProvider DataProvider
@@ -108,6 +158,7 @@ func NewTable(opts ...TableOption) *Table {
 		sorterStyle:  sorterStyle,
 		sorterHeight: sorterHeight,
 		spinner:      s,
+		prefetcher:   newPrefetcher(),
 	}
 
 	t.table = table.New(
@@ -142,17 +193,47 @@ func WithTableHelpText(text string) TableOption {
 	}
 }
 
+// WithSummaryMaxWidth lets the summary column expand up to width before being ellipsized.
+func WithSummaryMaxWidth(width int) TableOption {
+	return func(t *Table) {
+		t.summaryMaxWidth = width
+	}
+}
+
+// WithIssueLinks renders the issue keys as hyperlinks to their issues on server.
+func WithIssueLinks(server string) TableOption {
+	return func(t *Table) {
+		t.linkServer = server
+	}
+}
+
+// WithStatusLog reports failures of background requests to the log panel.
+func WithStatusLog(log *statusLog) TableOption {
+	return func(t *Table) {
+		t.statusLog = log
+	}
+}
+
+// WithFetcher sets where the details of the issues are fetched from.
+func WithFetcher(f api.Fetcher) TableOption {
+	return func(t *Table) {
+		t.fetcher = f
+	}
+}
+
 // Init initializes the table model.
 func (t *Table) Init() tea.Cmd {
 	return nil
 }
 
-func (t *Table) columnWidth(columnName string, data TableData) int {
+// columnWidths distributes the viewport width between the columns of data.
+func (t *Table) columnWidths(data TableData) []int {
 	if len(data) == 0 || len(data[0]) == 0 {
-		return 10 // fallback
+		return nil
 	}
 
-	numColumns := len(data[0])
+	headers := data[0]
+	numColumns := len(headers)
 
 	additionalSpaceForSummary := 10
 
@@ -160,23 +241,143 @@ func (t *Table) columnWidth(columnName string, data TableData) int {
 
 	availableSpace -= 2 * numColumns // Implicitly, bubbletea's table's columns are really ' ' + width + ' '. There is an implicit padding of 2 per column
 
-	colWidth := availableSpace / numColumns
-	if colWidth < 10 {
-		colWidth = 10 // Minimum column width
+	totalWeight := 0
+	for _, h := range headers {
+		totalWeight += t.columnWeight(h)
+	}
+
+	// Without configured weights every column weighs 1, which splits the space evenly.
+	widths := make([]int, numColumns)
+	usedSpace := 0
+	for i, h := range headers {
+		widths[i] = availableSpace * t.columnWeight(h) / totalWeight
+		if widths[i] < minColumnWidth {
+			widths[i] = minColumnWidth
+		}
+		usedSpace += widths[i]
+	}
+
+	summaryIdx := slices.Index(headers, FieldSummary)
+	if summaryIdx == -1 {
+		return widths
 	}
 
-	defaultWidth := colWidth
-	remainder := availableSpace - colWidth*numColumns
+	widths[summaryIdx] += availableSpace - usedSpace + additionalSpaceForSummary
 
-	if columnName == FieldSummary {
-		return defaultWidth + remainder + additionalSpaceForSummary
+	if t.summaryMaxWidth > 0 {
+		t.expandSummary(widths, summaryIdx, data)
 	}
 
-	return defaultWidth
+	return widths
 }
 
+// visibleColumns picks the columns of headers that fit in the viewport, KEY is
+// pinned first and the rest is a window starting at columnOffset. It also tells
+// if columns are scrolled out on the left or on the right.
+func (t *Table) visibleColumns(headers []string) (idx []int, hiddenLeft, hiddenRight bool) {
+	const additionalSpaceForSummary = 10
+
+	fit := max((t.viewportWidth-additionalSpaceForSummary)/(minColumnWidth+2), 1)
+	if len(headers) <= fit {
+		t.columnOffset = 0
+		idx = make([]int, len(headers))
+		for i := range headers {
+			idx[i] = i
+		}
+		return idx, false, false
+	}
+
+	var pinned, scrollable []int
+	for i, h := range headers {
+		if h == FieldKey {
+			pinned = append(pinned, i)
+		} else {
+			scrollable = append(scrollable, i)
+		}
+	}
+
+	window := max(fit-len(pinned), 1)
+	t.columnOffset = min(max(t.columnOffset, 0), len(scrollable)-window)
+
+	idx = append(pinned, scrollable[t.columnOffset:t.columnOffset+window]...)
+	return idx, t.columnOffset > 0, t.columnOffset+window < len(scrollable)
+}
+
+// scrollColumns shifts the window of visible columns by delta.
+func (t *Table) scrollColumns(delta int) {
+	t.columnOffset = max(t.columnOffset+delta, 0)
+}
+
+// selectColumns narrows data down to the columns idx.
+func selectColumns(data TableData, idx []int) TableData {
+	out := make(TableData, len(data))
+	for r, row := range data {
+		out[r] = make([]string, len(idx))
+		for i, c := range idx {
+			out[r][i] = row[c]
+		}
+	}
+	return out
+}
+
+// markScrolledColumns marks the headers at the edges of the window of columns
+// when columns are scrolled out on either side.
+func markScrolledColumns(columns []table.Column, hiddenLeft, hiddenRight bool) {
+	if len(columns) == 0 {
+		return
+	}
+	if hiddenLeft {
+		first := 0
+		if columns[0].Title == FieldKey && len(columns) > 1 {
+			first = 1
+		}
+		columns[first].Title = "« " + columns[first].Title
+	}
+	if hiddenRight {
+		columns[len(columns)-1].Title += " »"
+	}
+}
+
+// expandSummary grows the summary column up to the longest summary (capped by
+// summaryMaxWidth) by borrowing width from the widest other columns.
+func (t *Table) expandSummary(widths []int, summaryIdx int, data TableData) {
+	longest := 0
+	for _, row := range data[1:] {
+		longest = max(longest, lipgloss.Width(row[summaryIdx]))
+	}
+	target := min(longest, t.summaryMaxWidth)
+
+	for widths[summaryIdx] < target {
+		widest := -1
+		for i, w := range widths {
+			if i == summaryIdx || w <= minColumnWidth {
+				continue
+			}
+			if widest == -1 || w > widths[widest] {
+				widest = i
+			}
+		}
+		if widest == -1 {
+			return
+		}
+		widths[widest]--
+		widths[summaryIdx]++
+	}
+}
+
+// columnWeight returns the width weight configured for the column, defaulting to 1.
+func (t *Table) columnWeight(columnName string) int {
+	if w, ok := t.columnWeights[columnName]; ok && w > 0 {
+		return w
+	}
+	return 1
+}
+
+// filterTableData keeps the issues whose key and summary fuzzy match
+// filterText, best matches first, and remembers where their summaries matched.
 func (t *Table) filterTableData(filterText string) {
 	t.filteredIssues = []*jira.Issue{}
+	t.filterMatches = nil
 
 	// Special case: when just entered search, we should not
 	// immediately yank all content from under user's nose
@@ -185,13 +386,17 @@ func (t *Table) filterTableData(filterText string) {
 		return
 	}
 
-	for _, iss := range t.allIssues {
-		if strings.Contains(iss.Key, filterText) || strings.Contains(
-			strings.ToLower(iss.Fields.Summary),
-			strings.ToLower(filterText),
-		) {
-			t.filteredIssues = append(t.filteredIssues, iss)
-		}
+	targets := make([]string, len(t.allIssues))
+	for i, iss := range t.allIssues {
+		targets[i] = iss.Key + " " + prepareTitle(iss.Fields.Summary)
+	}
+
+	ranks := list.DefaultFilter(filterText, targets)
+	t.filterMatches = make(map[string]filterMatch, len(ranks))
+	for _, r := range ranks {
+		iss := t.allIssues[r.Index]
+		t.filteredIssues = append(t.filteredIssues, iss)
+		t.filterMatches[iss.Key] = newFilterMatch(targets[r.Index], r.MatchedIndexes, len(iss.Key)+1)
 	}
 }
 
@@ -245,6 +450,12 @@ func (t *Table) Update(msg tea.Msg) (*Table, tea.Cmd) {
 			t.SorterState = SorterFiltering
 			t.filterTableData(t.sorterText)
 			return t, cmd
+		case "<":
+			t.scrollColumns(-1)
+			return t, cmd
+		case ">":
+			t.scrollColumns(+1)
+			return t, cmd
 		}
 	}
 
@@ -261,9 +472,34 @@ func (t *Table) Update(msg tea.Msg) (*Table, tea.Cmd) {
 // SetIssueData sets the issue data for the table
 func (t *Table) SetIssueData(issues []*jira.Issue) {
 	t.allIssues = issues
+
+	t.cacheMu.Lock()
+	defer t.cacheMu.Unlock()
+	if t.issueCache == nil {
+		t.issueCache = make(map[string]*jira.Issue)
+	}
+}
+
+func (t *Table) cachedIssue(key string) (*jira.Issue, bool) {
+	t.cacheMu.Lock()
+	defer t.cacheMu.Unlock()
+	iss, ok := t.issueCache[key]
+	return iss, ok
+}
+
+func (t *Table) cacheIssue(key string, iss *jira.Issue) {
+	t.cacheMu.Lock()
+	defer t.cacheMu.Unlock()
 	if t.issueCache == nil {
 		t.issueCache = make(map[string]*jira.Issue)
 	}
+	t.issueCache[key] = iss
+}
+
+func (t *Table) evictIssue(key string) {
+	t.cacheMu.Lock()
+	defer t.cacheMu.Unlock()
+	delete(t.issueCache, key)
 }
 
 func (t *Table) SetBoardStateResolver(resolver *exp.BoardStateResolver) {
@@ -303,14 +539,24 @@ func (t *Table) setInnerTableColumnsRows() {
 		data = t.makeTableData(t.filteredIssues)
 	}
 
+	idx, hiddenLeft, hiddenRight := t.visibleColumns(data[0])
+	if len(idx) < len(data[0]) {
+		data = selectColumns(data, idx)
+	}
+
+	widths := t.columnWidths(data)
 	columns := make([]table.Column, len(data[0]))
+	t.summaryWidth = 0
 	for i, col := range data[0] {
-		oneWidth := t.columnWidth(col, data)
 		columns[i] = table.Column{
 			Title: col,
-			Width: oneWidth,
+			Width: widths[i],
+		}
+		if col == FieldSummary {
+			t.summaryWidth = widths[i]
 		}
 	}
+	markScrolledColumns(columns, hiddenLeft, hiddenRight)
 
 	rows := make([]table.Row, len(data)-1)
 	for i := 1; i < len(data); i++ {
@@ -365,7 +611,21 @@ func (t *Table) View() string {
 	t.table.SetWidth(t.viewportWidth)
 
 	// Render the table
-	tableView := t.baseStyle.Render(t.table.View())
+	tableView := t.table.View()
+	if t.SorterState != SorterInactive && t.summaryWidth > 0 {
+		tableView = t.highlightFilterMatches(tableView)
+	}
+	if t.linkServer != "" {
+		// Linked after rendering, the table would cut the escape sequences
+		// when truncating cells.
+		pool := t.issuePool()
+		keys := make(map[string]bool, len(pool))
+		for _, iss := range pool {
+			keys[iss.Key] = true
+		}
+		tableView = linkIssueKeys(tableView, t.linkServer, keys)
+	}
+	tableView = t.baseStyle.Render(tableView)
 	viewComponents = append(viewComponents, tableView)
 
 	// Join header and table vertically
@@ -407,6 +667,21 @@ func (t *Table) GetCursorRow() int {
 	return t.table.Cursor()
 }
 
+// selectKey moves the cursor to the issue with the given key, if it is in the list.
+func (t *Table) selectKey(key string) {
+	if key == "" {
+		return
+	}
+	for i, iss := range t.issuePool() {
+		if iss.Key == key {
+			// Rows are only filled on render, the cursor can't go past them.
+			t.setInnerTableColumnsRows()
+			t.table.SetCursor(i)
+			return
+		}
+	}
+}
+
 // SetFooterText updates the footer text dynamically
 func (t *Table) SetFooterText(text string) {
 	t.footerText = text
@@ -418,12 +693,23 @@ func (t *Table) SetDefaultFooterText() {
 
 func (t *Table) SetColumns(columns []string) {
 	t.columns = columns
+	t.customColumns = customColumns(columns)
 }
 
 func (t *Table) SetTimezone(timezone string) {
 	t.timezone = timezone
 }
 
+// SetColumnWeights sets per-column width weights, keyed by upper-cased column name.
+func (t *Table) SetColumnWeights(weights map[string]int) {
+	t.columnWeights = weights
+}
+
+// SetColumnFormats sets the format of each column, keyed by upper-cased column name.
+func (t *Table) SetColumnFormats(formats map[string]string) {
+	t.columnFormats = formats
+}
+
 // data prepares the data for table view.
 func (t *Table) makeTableData(issues []*jira.Issue) TableData {
 	var data TableData
@@ -437,10 +723,39 @@ func (t *Table) makeTableData(issues []*jira.Issue) TableData {
 	return data
 }
 
+// getColumnWeights reads width weights from `ui.list.column_widths`.
+func getColumnWeights() map[string]int {
+	var raw map[string]int
+	if err := viper.UnmarshalKey("ui.list.column_widths", &raw); err != nil {
+		return nil
+	}
+
+	weights := make(map[string]int, len(raw))
+	for k, v := range raw {
+		weights[strings.ToUpper(k)] = v
+	}
+	return weights
+}
+
+// getSummaryMaxWidth returns the width SUMMARY may expand to when truncation is disabled.
+func getSummaryMaxWidth() int {
+	if !viper.GetBool("ui.list.no_truncate") {
+		return 0
+	}
+	if w := viper.GetInt("ui.list.summary_max_width"); w > 0 {
+		return w
+	}
+	return defaultSummaryMaxWidth
+}
+
 // header prepares table headers.
 func (t *Table) header() []string {
 	headers := []string{}
 	for _, c := range t.columns {
+		if header, ok := t.customColumnHeader(c); ok {
+			headers = append(headers, header)
+			continue
+		}
 		c = strings.ToUpper(c)
 		if slices.Contains(ValidIssueColumns(), c) {
 			headers = append(headers, c)
@@ -455,35 +770,49 @@ func (t *Table) assignColumns(columns []string, issue *jira.Issue) []string {
 	var bucket []string
 
 	for _, column := range columns {
+		if value, ok := t.formatColumn(column, issue); ok {
+			bucket = append(bucket, value)
+			continue
+		}
+		if id, ok := t.customColumns[column]; ok {
+			bucket = append(bucket, issue.Fields.CustomField(id))
+			continue
+		}
+
 		switch column {
 		case FieldType:
-			bucket = append(bucket, issue.Fields.IssueType.Name)
+			// Only configured icons are shown, the defaults would just add noise to the list
+			if icon, ok := cmdutil.IssueTypeIcon(issue.Fields.IssueType.Name); ok {
+				bucket = append(bucket, icon+" "+issue.Fields.IssueType.Name)
+			} else {
+				bucket = append(bucket, issue.Fields.IssueType.Name)
+			}
 		case FieldParent:
 			if issue.Fields.Parent != nil {
-				bucket = append(bucket, issue.Fields.Parent.Key)
+				bucket = append(bucket, t.parentLabel(issue))
 			} else {
 				bucket = append(bucket, "")
 			}
 		case FieldKey:
-			bucket = append(bucket, issue.Key)
+			if t.marked[issue.Key] {
+				bucket = append(bucket, markPrefix+issue.Key)
+			} else {
+				bucket = append(bucket, issue.Key)
+			}
 		case FieldSummary:
-			bucket = append(bucket, prepareTitle(issue.Fields.Summary))
+			bucket = append(bucket, shorten(prepareTitle(issue.Fields.Summary), getSummaryMaxLength()))
 		case FieldStatus:
 			bucket = append(bucket, issue.Fields.Status.Name)
-		case FieldAssignee:
-			bucket = append(bucket, issue.Fields.Assignee.Name)
-		case FieldReporter:
-			bucket = append(bucket, issue.Fields.Reporter.Name)
 		case FieldPriority:
 			bucket = append(bucket, issue.Fields.Priority.Name)
 		case FieldResolution:
 			bucket = append(bucket, issue.Fields.Resolution.Name)
-		case FieldCreated:
-			bucket = append(bucket, FormatDateTime(issue.Fields.Created, jira.RFC3339, t.timezone))
-		case FieldUpdated:
-			bucket = append(bucket, FormatDateTime(issue.Fields.Updated, jira.RFC3339, t.timezone))
-		case FieldLabels:
-			bucket = append(bucket, strings.Join(issue.Fields.Labels, ","))
+		case FieldBlocked:
+			if isBlocked(issue) {
+				bucket = append(bucket, "🚫")
+			} else {
+				bucket = append(bucket, "")
+			}
 		case FieldIsOnBoard:
 			if t.boardStateResolver != nil && t.boardStateResolver.IsOnBoard(issue.Key) {
 				bucket = append(bucket, "Yes")
@@ -495,31 +824,60 @@ func (t *Table) assignColumns(columns []string, issue *jira.Issue) []string {
 	return bucket
 }
 
-func (t *Table) GetIssueSync(shift int) *jira.Issue {
+// isBlocked tells if the issue is blocked by an issue that isn't done yet. The
+// linked issues come with their status in the search results.
+func isBlocked(issue *jira.Issue) bool {
+	for _, link := range issue.Fields.IssueLinks {
+		if link.InwardIssue == nil || !strings.EqualFold(link.LinkType.Inward, "is blocked by") {
+			continue
+		}
+		if !cmdutil.IsDoneStatus(link.InwardIssue.Fields.Status) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetIssueSync returns the issue near the cursor, fetching it if it isn't cached yet.
+func (t *Table) GetIssueSync(shift int) (*jira.Issue, error) {
 	key := t.getKeyUnderCursorWithShift(shift)
+	if key == "" {
+		return nil, errNoIssueSelected
+	}
 
-	if iss, ok := t.issueCache[key]; ok {
-		return iss
+	if iss, ok := t.cachedIssue(key); ok {
+		return iss, nil
 	}
 
-	iss, err := api.ProxyGetIssue(api.DefaultClient(false), key, issue.NewNumCommentsFilter(10))
+	iss, err := t.fetchIssue(key)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
-	t.issueCache[key] = iss
+	t.cacheIssue(key, iss)
 
-	return iss
+	return iss, nil
 }
 
-func (t *Table) getKeyUnderCursorWithShift(shift int) string {
-	row := t.GetCursorRow()
-	var issuePool []*jira.Issue
+// fetchIssue fetches the details of the issue, bypassing the cache.
+func (t *Table) fetchIssue(key string) (*jira.Issue, error) {
+	if t.fetcher == nil {
+		return nil, errNoFetcher
+	}
+	return t.fetcher.GetIssue(key, issue.NewNumCommentsFilter(10))
+}
+
+// issuePool returns the issues currently listed in the table, respecting the filter.
+func (t *Table) issuePool() []*jira.Issue {
 	if t.SorterState == SorterInactive {
-		issuePool = t.allIssues
-	} else {
-		issuePool = t.filteredIssues
+		return t.allIssues
 	}
+	return t.filteredIssues
+}
+
+func (t *Table) getKeyUnderCursorWithShift(shift int) string {
+	row := t.GetCursorRow()
+	issuePool := t.issuePool()
 	pos := row + shift
 	if pos < 0 {
 		pos = 0
@@ -542,16 +900,16 @@ func (t *Table) GetIssueAsync(i int, shift int) tea.Cmd {
 			return NopMsg{}
 		}
 
-		if iss, ok := t.issueCache[key]; ok {
+		if iss, ok := t.cachedIssue(key); ok {
 			return IncomingIssueMsg{index: i, issue: iss}
 		}
 
-		iss, err := api.ProxyGetIssue(api.DefaultClient(false), key, issue.NewNumCommentsFilter(10))
+		iss, err := t.fetchIssue(key)
 		if err != nil {
-			panic(err)
+			return IssueFetchErrorMsg{issueKey: key, err: err}
 		}
 
-		t.issueCache[key] = iss
+		t.cacheIssue(key, iss)
 		return IncomingIssueMsg{index: i, issue: iss}
 	}
 }
@@ -0,0 +1,319 @@
+package bubble
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+	"github.com/jorres/jira-tui/pkg/jira/filter"
+)
+
+func TestVisibleColumns(t *testing.T) {
+	headers := []string{FieldType, FieldKey, FieldSummary, FieldStatus, FieldAssignee, FieldReporter, FieldCreated, FieldUpdated}
+
+	tbl := NewTable()
+	tbl.viewportWidth = 200
+
+	idx, left, right := tbl.visibleColumns(headers)
+	assert.Len(t, idx, len(headers))
+	assert.False(t, left)
+	assert.False(t, right)
+
+	// 4 columns fit: KEY and a window of 3
+	tbl.viewportWidth = 10 + 4*(minColumnWidth+2)
+
+	idx, left, right = tbl.visibleColumns(headers)
+	assert.Equal(t, []int{1, 0, 2, 3}, idx)
+	assert.False(t, left)
+	assert.True(t, right)
+
+	tbl.scrollColumns(+2)
+	idx, left, right = tbl.visibleColumns(headers)
+	assert.Equal(t, []int{1, 3, 4, 5}, idx)
+	assert.True(t, left)
+	assert.True(t, right)
+
+	// scrolling stops at the last column
+	tbl.scrollColumns(+10)
+	idx, left, right = tbl.visibleColumns(headers)
+	assert.Equal(t, []int{1, 5, 6, 7}, idx)
+	assert.True(t, left)
+	assert.False(t, right)
+
+	tbl.scrollColumns(-10)
+	idx, _, _ = tbl.visibleColumns(headers)
+	assert.Equal(t, []int{1, 0, 2, 3}, idx)
+}
+
+func TestAssignColumnsVersionsAndComponents(t *testing.T) {
+	var iss jira.Issue
+	err := json.Unmarshal([]byte(`{
+		"key": "TEST-1",
+		"fields": {
+			"fixVersions": [{"name": "1.0"}, {"name": "1.1"}],
+			"components": [{"name": "Backend"}]
+		}
+	}`), &iss)
+	assert.NoError(t, err)
+
+	tbl := &Table{}
+	columns := []string{FieldKey, FieldFixVersions, FieldComponents}
+	assert.Equal(t, []string{"TEST-1", "1.0,1.1", "Backend"}, tbl.assignColumns(columns, &iss))
+
+	iss.Fields.FixVersions, iss.Fields.Components = nil, nil
+	assert.Equal(t, []string{"TEST-1", "", ""}, tbl.assignColumns(columns, &iss))
+}
+
+func TestLinkIssueKeys(t *testing.T) {
+	keys := map[string]bool{"TEST-1": true, "TEST-12": true}
+	out := linkIssueKeys("\x1b[1mTEST-1\x1b[0m  TEST-12  TEST-123  SUBTEST-1", "https://jira.example.com", keys)
+
+	assert.Equal(t,
+		"\x1b[1m\x1b]8;;https://jira.example.com/browse/TEST-1\x1b\\TEST-1\x1b]8;;\x1b\\\x1b[0m  "+
+			"\x1b]8;;https://jira.example.com/browse/TEST-12\x1b\\TEST-12\x1b]8;;\x1b\\  TEST-123  SUBTEST-1",
+		out)
+}
+
+func TestFilterTableDataFuzzy(t *testing.T) {
+	tbl := NewTable()
+	tbl.allIssues = []*jira.Issue{
+		{Key: "XYZ-2", Fields: jira.IssueFields{Summary: "Add a bug report template"}},
+		{Key: "ABC-1", Fields: jira.IssueFields{Summary: "Login bug"}},
+		{Key: "ABC-3", Fields: jira.IssueFields{Summary: "Update docs"}},
+	}
+
+	tbl.filterTableData("abcbug")
+	assert.Len(t, tbl.filteredIssues, 1)
+	assert.Equal(t, "ABC-1", tbl.filteredIssues[0].Key)
+	assert.Equal(t, []int{6, 7, 8}, tbl.filterMatches["ABC-1"].matched)
+
+	// the closer match ranks first
+	tbl.filterTableData("bug")
+	assert.Len(t, tbl.filteredIssues, 2)
+	assert.Equal(t, "ABC-1", tbl.filteredIssues[0].Key)
+}
+
+func TestHighlightSummary(t *testing.T) {
+	shown, highlighted := highlightSummary("Login bug", []int{0, 6, 7, 8}, 20)
+	assert.Equal(t, "Login bug", shown)
+	assert.Equal(t, "\x1b[4mL\x1b[24mogin \x1b[4mbug\x1b[24m", highlighted)
+
+	// matches past the truncation are not shown
+	shown, highlighted = highlightSummary("Login bug", []int{0, 6, 7, 8}, 6)
+	assert.Equal(t, "Login…", shown)
+	assert.Equal(t, "\x1b[4mL\x1b[24mogin…", highlighted)
+}
+
+func TestAssignColumnsBlocked(t *testing.T) {
+	var iss jira.Issue
+	err := json.Unmarshal([]byte(`{
+		"key": "TEST-1",
+		"fields": {
+			"issuelinks": [
+				{
+					"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"},
+					"inwardIssue": {"key": "TEST-2", "fields": {"status": {"name": "Done", "statusCategory": {"key": "done"}}}}
+				},
+				{
+					"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"},
+					"outwardIssue": {"key": "TEST-3", "fields": {"status": {"name": "To Do", "statusCategory": {"key": "new"}}}}
+				},
+				{
+					"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"},
+					"inwardIssue": {"key": "TEST-4", "fields": {"status": {"name": "In Progress", "statusCategory": {"key": "indeterminate"}}}}
+				}
+			]
+		}
+	}`), &iss)
+	assert.NoError(t, err)
+
+	tbl := &Table{}
+	columns := []string{FieldKey, FieldBlocked}
+	assert.Equal(t, []string{"TEST-1", "🚫"}, tbl.assignColumns(columns, &iss))
+
+	// blocking an open issue or being blocked by a done one doesn't count
+	iss.Fields.IssueLinks = iss.Fields.IssueLinks[:2]
+	assert.Equal(t, []string{"TEST-1", ""}, tbl.assignColumns(columns, &iss))
+}
+
+func TestIssueCache(t *testing.T) {
+	tbl := NewTable()
+	iss := &jira.Issue{Key: "TEST-1"}
+
+	tbl.cacheIssue("TEST-1", iss)
+	cached, ok := tbl.cachedIssue("TEST-1")
+	assert.True(t, ok)
+	assert.Same(t, iss, cached)
+
+	tbl.evictIssue("TEST-1")
+	_, ok = tbl.cachedIssue("TEST-1")
+	assert.False(t, ok)
+}
+
+func TestParentColumn(t *testing.T) {
+	var child, orphan, sibling jira.Issue
+	assert.NoError(t, json.Unmarshal([]byte(`{"key": "TEST-2", "fields": {"parent": {"key": "TEST-1", "fields": {"summary": "Epic name"}}}}`), &child))
+	assert.NoError(t, json.Unmarshal([]byte(`{"key": "TEST-3", "fields": {"parent": {"key": "TEST-9"}}}`), &orphan))
+	assert.NoError(t, json.Unmarshal([]byte(`{"key": "TEST-4", "fields": {"parent": {"key": "TEST-9"}}}`), &sibling))
+
+	tbl := &Table{columns: []string{FieldKey, FieldParent}, allIssues: []*jira.Issue{&child, &orphan, &sibling}}
+	assert.Equal(t, []string{"TEST-2", "TEST-1: Epic name"}, tbl.assignColumns(tbl.columns, &child))
+	assert.Equal(t, []string{"TEST-3", "TEST-9"}, tbl.assignColumns(tbl.columns, &orphan))
+
+	// the parent shared by two rows is looked up once
+	assert.Equal(t, []string{"TEST-9"}, tbl.missingParents())
+
+	tbl.parentSummaries["TEST-9"] = "Another epic"
+	assert.Equal(t, []string{"TEST-4", "TEST-9: Another epic"}, tbl.assignColumns(tbl.columns, &sibling))
+	assert.Empty(t, tbl.missingParents())
+}
+
+// searchFunc is a Fetcher serving searches from a function.
+type searchFunc func(jql string) (*jira.SearchResult, error)
+
+func (f searchFunc) Search(jql string, _, _ uint) (*jira.SearchResult, error) { return f(jql) }
+
+func (f searchFunc) GetIssue(string, ...filter.Filter) (*jira.Issue, error) { return nil, nil }
+
+// issueFetcher is a Fetcher serving the issues it holds, keyed by the key.
+type issueFetcher map[string]*jira.Issue
+
+func (f issueFetcher) Search(string, uint, uint) (*jira.SearchResult, error) {
+	return &jira.SearchResult{}, nil
+}
+
+func (f issueFetcher) GetIssue(key string, _ ...filter.Filter) (*jira.Issue, error) {
+	if iss, ok := f[key]; ok {
+		return iss, nil
+	}
+	return nil, fmt.Errorf("issue %s not found", key)
+}
+
+func TestLookupParentSummaries(t *testing.T) {
+	badRequest := &jira.ErrUnexpectedResponse{StatusCode: http.StatusBadRequest}
+	var queries []string
+	f := searchFunc(func(jql string) (*jira.SearchResult, error) {
+		queries = append(queries, jql)
+		switch jql {
+		case "key = TEST-1":
+			iss := &jira.Issue{Key: "TEST-1"}
+			iss.Fields.Summary = "Epic name"
+			return &jira.SearchResult{Total: 1, Issues: []*jira.Issue{iss}}, nil
+		case "key = TEST-3":
+			return nil, errors.New("connection reset")
+		}
+		return nil, badRequest
+	})
+
+	// one deleted parent doesn't blank the others
+	summaries, err := lookupParentSummaries(f, []string{"TEST-1", "TEST-2", "TEST-3"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"TEST-1": "Epic name", "TEST-2": ""}, summaries)
+	assert.Equal(t, []string{"key IN (TEST-1, TEST-2, TEST-3)", "key = TEST-1", "key = TEST-2", "key = TEST-3"}, queries)
+
+	f = func(string) (*jira.SearchResult, error) { return nil, errors.New("connection reset") }
+	_, err = lookupParentSummaries(f, []string{"TEST-1"})
+	assert.Error(t, err)
+}
+
+func TestColumnFormats(t *testing.T) {
+	t.Cleanup(viper.Reset)
+	now := time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	t.Cleanup(func() { timeNow = time.Now })
+
+	var iss jira.Issue
+	err := json.Unmarshal([]byte(`{
+		"key": "TEST-1",
+		"fields": {
+			"assignee": {"displayName": "Jane van Doe", "emailAddress": "jane@example.com"},
+			"reporter": {"displayName": "John Roe"},
+			"created": "2025-05-07T09:30:00.000+0000",
+			"labels": ["backend", "urgent", "needs-triage"],
+			"components": [{"name": "API"}]
+		}
+	}`), &iss)
+	assert.NoError(t, err)
+
+	columns := []string{FieldAssignee, FieldReporter, FieldCreated, FieldLabels, FieldComponents}
+
+	tbl := &Table{}
+	assert.Equal(t, []string{"Jane van Doe", "John Roe", "2025-05-07 09:30", "backend,urgent,needs-triage", "API"},
+		tbl.assignColumns(columns, &iss))
+
+	viper.Set("ui.list.column_formats", map[string]string{
+		"assignee":   "initials",
+		"REPORTER":   "email",
+		"created":    "relative",
+		"labels":     "first",
+		"components": "count",
+		"status":     "initials",
+		"updated":    "bogus",
+	})
+	tbl.SetColumnFormats(getColumnFormats())
+	assert.Equal(t, map[string]string{
+		FieldAssignee: "initials", FieldReporter: "email", FieldCreated: "relative",
+		FieldLabels: "first", FieldComponents: "count",
+	}, tbl.columnFormats)
+
+	// The reporter's email is hidden, the name is shown instead.
+	assert.Equal(t, []string{"JVD", "John Roe", "3d ago", "backend +2", "1"}, tbl.assignColumns(columns, &iss))
+
+	tbl.columnFormats = map[string]string{FieldAssignee: "email", FieldCreated: "date"}
+	assert.Equal(t, []string{"jane@example.com", "2025-05-07"}, tbl.assignColumns([]string{FieldAssignee, FieldCreated}, &iss))
+
+	assert.Equal(t, "just now", relativeTime(30*time.Second))
+	assert.Equal(t, "5m ago", relativeTime(5*time.Minute))
+	assert.Equal(t, "2mo ago", relativeTime(65*24*time.Hour))
+	assert.Equal(t, "1y ago", relativeTime(400*24*time.Hour))
+}
+
+func TestCustomFieldColumns(t *testing.T) {
+	t.Cleanup(viper.Reset)
+	viper.Set("issue.fields.custom", []map[string]any{
+		{"name": "Team", "key": "customfield_10020"},
+		{"name": "Severity", "key": "customfield_10030"},
+	})
+
+	var iss jira.Issue
+	err := json.Unmarshal([]byte(`{
+		"key": "TEST-1",
+		"fields": {
+			"summary": "Outage",
+			"customfield_10020": {"value": "Platform"},
+			"customfield_10030": {"value": "Sev-1"},
+			"customfield_10040": 3
+		}
+	}`), &iss)
+	assert.NoError(t, err)
+
+	tbl := &Table{}
+	tbl.SetColumns([]string{"key", "team", "customfield_10030", "customfield_10040", "customfield_10050", "bogus"})
+
+	headers := tbl.header()
+	assert.Equal(t, []string{FieldKey, "TEAM", "SEVERITY", "CUSTOMFIELD_10040", "CUSTOMFIELD_10050"}, headers)
+	assert.Equal(t, []string{"TEST-1", "Platform", "Sev-1", "3", ""}, tbl.assignColumns(headers, &iss))
+}
+
+func TestSummaryMaxLength(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	iss := &jira.Issue{Key: "TEST-1", Fields: jira.IssueFields{Summary: "Crash when opening a very long ticket"}}
+	iss.Fields.Subtasks = []jira.Issue{{Key: "TEST-2", Fields: jira.IssueFields{Summary: "Reproduce the crash on staging"}}}
+
+	tbl := &Table{}
+	columns := []string{FieldKey, FieldSummary}
+	assert.Equal(t, []string{"TEST-1", "Crash when opening a very long ticket"}, tbl.assignColumns(columns, iss))
+	assert.Contains(t, (&IssueModel{Data: iss}).subtasks(), "Reproduce the crash on staging")
+
+	viper.Set("ui.summary_max_length", 12)
+	assert.Equal(t, []string{"TEST-1", "Crash when …"}, tbl.assignColumns(columns, iss))
+	assert.Contains(t, stripANSI((&IssueModel{Data: iss}).subtasks()), "TEST-2 Reproduce t… •")
+}
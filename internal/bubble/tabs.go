@@ -0,0 +1,249 @@
+package bubble
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/internal/config"
+)
+
+// maxNumberedTabs is the number of tabs that can be jumped to with a number key.
+const maxNumberedTabs = 9
+
+const (
+	// tabOrderKey lists the tab names in the order they were arranged in.
+	tabOrderKey = "ui.tabs.order"
+	// tabHiddenKey lists the names of the hidden tabs.
+	tabHiddenKey = "ui.tabs.hidden"
+)
+
+// getCompactTabs reads `ui.tabs.compact`, if set the tab bar is a single line
+// instead of bordered tabs taking two.
+func getCompactTabs() bool {
+	return viper.GetBool("ui.tabs.compact")
+}
+
+// tabBarHeight is the number of rows taken by the tab bar, it is only shown
+// when there is more than one tab.
+func (l *IssueList) tabBarHeight() int {
+	switch {
+	case len(l.visibleTabs()) <= 1:
+		return 0
+	case getCompactTabs():
+		return 1
+	default:
+		return 2
+	}
+}
+
+// renderCompactTabs renders the tabs on one line as "[1·Issues] 2·Bugs 3·Review",
+// the active one bracketed. The line is cut at the window width.
+func (l *IssueList) renderCompactTabs() string {
+	activeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(getHighlightColor())).Bold(true)
+	inactiveStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(getPaleColor()))
+
+	visible := l.visibleTabs()
+	parts := make([]string, 0, len(visible))
+	for pos, i := range visible {
+		label := l.tabs[i].Name
+		if pos < maxNumberedTabs {
+			label = fmt.Sprintf("%d·%s", pos+1, label)
+		}
+		if i == l.activeTab {
+			parts = append(parts, activeStyle.Render("["+label+"]"))
+		} else {
+			parts = append(parts, inactiveStyle.Render(label))
+		}
+	}
+
+	line := " " + strings.Join(parts, " ")
+	if l.rawWidth > 0 {
+		line = lipgloss.NewStyle().MaxWidth(l.rawWidth).Render(line)
+	}
+	return line
+}
+
+// tabNumberKey tells the position of the tab the key jumps to. alt+1 to alt+9 always do, the
+// plain digits only when they aren't bound to quick filters.
+func tabNumberKey(key string) (int, bool) {
+	digit, alt := strings.CutPrefix(key, "alt+")
+	if len(digit) != 1 || digit[0] < '1' || digit[0] > '9' {
+		return 0, false
+	}
+	if !alt && len(getQuickFilters()) > 0 {
+		return 0, false
+	}
+	return int(digit[0] - '1'), true
+}
+
+// selectTab switches to the tab, restarting the spinners of its views.
+func (l *IssueList) selectTab(index int) tea.Cmd {
+	if index < 0 || index >= len(l.tabs) || index == l.activeTab {
+		return nil
+	}
+	l.activeTab = index
+	tableSpinner := l.getCurrentTable().spinner.Tick
+	issueSpinner := l.getCurrentIssueDetailView().spinner.Tick
+	return tea.Batch(tableSpinner, issueSpinner)
+}
+
+// cycleTab selects the next visible tab to the right, or to the left for a
+// negative delta, wrapping around.
+func (l *IssueList) cycleTab(delta int) tea.Cmd {
+	visible := l.visibleTabs()
+	if len(visible) <= 1 {
+		return nil
+	}
+	pos := max(slices.Index(visible, l.activeTab), 0)
+	return l.selectTab(visible[(pos+delta+len(visible))%len(visible)])
+}
+
+// orderedTabs returns the indexes of the tabs in the order they are shown,
+// the tabs opened during the session come last.
+func (l *IssueList) orderedTabs() []int {
+	order := make([]int, 0, len(l.tabs))
+	for _, i := range l.tabOrder {
+		if i < len(l.tabs) && !slices.Contains(order, i) {
+			order = append(order, i)
+		}
+	}
+	for i := range l.tabs {
+		if !slices.Contains(order, i) {
+			order = append(order, i)
+		}
+	}
+	return order
+}
+
+// visibleTabs returns the indexes of the tabs that aren't hidden, in order.
+func (l *IssueList) visibleTabs() []int {
+	var visible []int
+	for _, i := range l.orderedTabs() {
+		if !l.tabs[i].hidden {
+			visible = append(visible, i)
+		}
+	}
+	return visible
+}
+
+// applyTabLayout orders and hides the tabs the way they were left in the
+// previous session, the first visible tab is selected.
+func (l *IssueList) applyTabLayout() {
+	for _, name := range viper.GetStringSlice(tabOrderKey) {
+		for i, tab := range l.tabs {
+			if tab.Name == name && !slices.Contains(l.tabOrder, i) {
+				l.tabOrder = append(l.tabOrder, i)
+				break
+			}
+		}
+	}
+
+	hidden := viper.GetStringSlice(tabHiddenKey)
+	for _, tab := range l.tabs {
+		tab.hidden = slices.Contains(hidden, tab.Name)
+	}
+
+	visible := l.visibleTabs()
+	if len(visible) == 0 {
+		// The tabs were renamed since, at least one has to be shown
+		for _, tab := range l.tabs {
+			tab.hidden = false
+		}
+		visible = l.visibleTabs()
+	}
+	if len(visible) > 0 {
+		l.activeTab = visible[0]
+	}
+}
+
+// moveTab moves the active tab one position to the right of the tab bar, or
+// to the left for a negative delta, past the hidden tabs.
+func (l *IssueList) moveTab(delta int) tea.Cmd {
+	order := l.orderedTabs()
+	pos := slices.Index(order, l.activeTab)
+
+	target := pos + delta
+	for target >= 0 && target < len(order) && l.tabs[order[target]].hidden {
+		target += delta
+	}
+	if target < 0 || target >= len(order) {
+		return nil
+	}
+
+	order[pos], order[target] = order[target], order[pos]
+	l.tabOrder = order
+	l.tabsRearranged = true
+	return nil
+}
+
+// hideTab hides the active tab until it is shown again, selecting its neighbour.
+func (l *IssueList) hideTab() tea.Cmd {
+	visible := l.visibleTabs()
+	if len(visible) <= 1 {
+		return l.setStatusMessage("The last tab can't be hidden")
+	}
+
+	pos := slices.Index(visible, l.activeTab)
+	next := visible[max(pos-1, 0)]
+	if pos < len(visible)-1 {
+		next = visible[pos+1]
+	}
+
+	tab := l.tabs[l.activeTab]
+	tab.hidden = true
+	l.tabsRearranged = true
+	return tea.Batch(
+		l.selectTab(next),
+		l.setStatusMessage(fmt.Sprintf("Tab %q hidden, alt+u shows hidden tabs", tab.Name)),
+		l.resizeTabs(),
+	)
+}
+
+// showHiddenTabs shows all the hidden tabs again.
+func (l *IssueList) showHiddenTabs() tea.Cmd {
+	n := 0
+	for _, tab := range l.tabs {
+		if tab.hidden {
+			tab.hidden = false
+			n++
+		}
+	}
+	if n == 0 {
+		return l.setStatusMessage("No hidden tabs")
+	}
+	l.tabsRearranged = true
+	return tea.Batch(l.setStatusMessage(fmt.Sprintf("%d hidden tab(s) shown", n)), l.resizeTabs())
+}
+
+// resizeTabs lays the list out again, the tab bar goes away with the second
+// to last visible tab.
+func (l *IssueList) resizeTabs() tea.Cmd {
+	width, height := l.rawWidth, l.rawHeight
+	return func() tea.Msg { return tea.WindowSizeMsg{Width: width, Height: height} }
+}
+
+// saveTabLayout saves the order of the tabs and the hidden ones to the config,
+// if they were rearranged during the session.
+func (l *IssueList) saveTabLayout() error {
+	if !l.tabsRearranged {
+		return nil
+	}
+
+	order, hidden := []string{}, []string{}
+	for _, i := range l.orderedTabs() {
+		order = append(order, l.tabs[i].Name)
+		if l.tabs[i].hidden {
+			hidden = append(hidden, l.tabs[i].Name)
+		}
+	}
+
+	if err := config.Set(tabOrderKey, order); err != nil {
+		return err
+	}
+	return config.Set(tabHiddenKey, hidden)
+}
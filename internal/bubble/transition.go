@@ -0,0 +1,99 @@
+package bubble
+
+import (
+	"github.com/charmbracelet/bubbles/v2/list"
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/api"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// pendingMove is the transition chosen for an issue while its resolution is
+// being selected.
+type pendingMove struct {
+	issue      *jira.Issue
+	transition *jira.Transition
+}
+
+// resolutionItem allows for `Resolution` type to be passed to FuzzySelector,
+// the description field clashes with the list item method otherwise.
+type resolutionItem struct {
+	*jira.Resolution
+}
+
+func (r resolutionItem) FilterValue() string { return r.Name }
+func (r resolutionItem) Title() string       { return r.Name }
+func (r resolutionItem) Description() string { return r.Resolution.Description }
+
+func transitionsToItems(transitions []*jira.Transition) []list.Item {
+	cloud := viper.GetString("installation") == jira.InstallationTypeCloud
+
+	listItems := []list.Item{}
+	for _, t := range transitions {
+		if cloud && !t.IsAvailable {
+			continue
+		}
+		listItems = append(listItems, t)
+	}
+	return listItems
+}
+
+func resolutionsToItems(resolutions []*jira.Resolution) []list.Item {
+	listItems := []list.Item{}
+	for _, r := range resolutions {
+		listItems = append(listItems, resolutionItem{r})
+	}
+	return listItems
+}
+
+// selectTransition opens the selector of the states the issue can be moved to,
+// transitions depend on the issue so they are loaded every time.
+func (l *IssueList) selectTransition(iss *jira.Issue) (tea.Model, tea.Cmd) {
+	fz := NewFuzzySelectorFrom(l, l.rawWidth, l.rawHeight, []list.Item{}, FuzzySelectorTransition)
+	return fz, tea.Batch(fz.list.StartSpinner(), func() tea.Msg {
+		transitions, err := api.ProxyTransitions(l.c, iss.Key)
+		return TransitionsMsg{transitions: transitions, err: err}
+	})
+}
+
+// selectResolution opens the resolution selector for the transition whose
+// screen asks for one, loading the resolutions in background the first time.
+func (l *IssueList) selectResolution(iss *jira.Issue, tr *jira.Transition) (tea.Model, tea.Cmd) {
+	l.pendingMove = &pendingMove{issue: iss, transition: tr}
+
+	if l.cachedResolutions == nil {
+		fz := NewFuzzySelectorFrom(l, l.rawWidth, l.rawHeight, []list.Item{}, FuzzySelectorResolution)
+		return fz, tea.Batch(fz.list.StartSpinner(), func() tea.Msg {
+			resolutions, err := l.c.GetResolutions()
+			return ResolutionsMsg{resolutions: resolutions, err: err}
+		})
+	}
+
+	fz := NewFuzzySelectorFrom(l, l.rawWidth, l.rawHeight, resolutionsToItems(l.cachedResolutions), FuzzySelectorResolution)
+	return fz, nil
+}
+
+// transitionIssue moves the issue with the chosen transition, setting the
+// resolution if it is not empty.
+func (l *IssueList) transitionIssue(iss *jira.Issue, tr *jira.Transition, resolution string) tea.Cmd {
+	return func() tea.Msg {
+		req := jira.TransitionRequest{
+			Fields: &jira.TransitionRequestFields{},
+			Transition: &jira.TransitionRequestData{
+				ID:   tr.ID.String(),
+				Name: tr.Name,
+			},
+		}
+		if resolution != "" {
+			req.Fields.Resolution = &struct {
+				Name string `json:"name"`
+			}{Name: resolution}
+		}
+
+		if _, err := l.c.Transition(iss.Key, &req); err != nil {
+			return IssueMovedMsg{issueKey: iss.Key, err: err, stderr: err.Error()}
+		}
+		return IssueMovedMsg{issueKey: iss.Key, state: tr.Name}
+	}
+}
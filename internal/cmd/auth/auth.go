@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/jorres/jira-tui/internal/cmd/auth/clear"
+	"github.com/jorres/jira-tui/internal/cmd/auth/set"
+)
+
+const helpText = `Auth manages the API token or password stored in the system keyring.
+
+The stored secret is used when JIRA_API_TOKEN is not set and there is no
+matching .netrc entry, so it doesn't have to live in your shell config or dotfiles.`
+
+// NewCmdAuth is an auth command.
+func NewCmdAuth() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "auth",
+		Short:       "Auth manages credentials stored in the system keyring",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        auth,
+	}
+
+	cmd.AddCommand(set.NewCmdSet(), clear.NewCmdClear())
+
+	return &cmd
+}
+
+func auth(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
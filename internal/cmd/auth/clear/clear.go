@@ -0,0 +1,49 @@
+package clear
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zalando/go-keyring"
+
+	"github.com/jorres/jira-tui/api"
+	"github.com/jorres/jira-tui/internal/cmdutil"
+)
+
+// NewCmdClear is an auth clear command.
+func NewCmdClear() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "clear",
+		Short: "Remove the stored secret from the system keyring",
+		Long:  "Clear removes the API token or password stored with 'jira auth set' from the system keyring.",
+		Args:  cobra.NoArgs,
+		Run:   clearSecret,
+	}
+
+	cmd.Flags().String("login", "", "Login to remove the secret for (defaults to the configured login)")
+
+	return &cmd
+}
+
+func clearSecret(cmd *cobra.Command, _ []string) {
+	login, err := cmd.Flags().GetString("login")
+	cmdutil.ExitIfError(err)
+
+	if login == "" {
+		login = viper.GetString("login")
+	}
+	if login == "" {
+		cmdutil.Failed("Unable to determine the login, pass it with --login")
+	}
+
+	err = keyring.Delete(api.KeyringService, login)
+	switch {
+	case errors.Is(err, keyring.ErrNotFound):
+		cmdutil.Warn("No secret stored for %q", login)
+	case err != nil:
+		cmdutil.Failed("Unable to access the system keyring: %s", err)
+	default:
+		cmdutil.Success("Secret for %q removed from the system keyring", login)
+	}
+}
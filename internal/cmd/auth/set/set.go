@@ -0,0 +1,91 @@
+package set
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zalando/go-keyring"
+
+	"github.com/jorres/jira-tui/api"
+	"github.com/jorres/jira-tui/internal/cmdutil"
+)
+
+const (
+	helpText = `Set stores an API token, or a password for basic auth on Jira Server/Data Center,
+in the system keyring for the configured login.
+
+The secret is prompted for, or read from the standard input if it is not a terminal.`
+
+	examples = `$ jira auth set
+
+# Read the token from a password manager
+$ pass show jira | jira auth set
+
+# Store the token for another login
+$ jira auth set --login jon@example.com`
+)
+
+// NewCmdSet is an auth set command.
+func NewCmdSet() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "set",
+		Short:   "Store an API token or password in the system keyring",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     setSecret,
+	}
+
+	cmd.Flags().String("login", "", "Login to store the secret for (defaults to the configured login)")
+
+	return &cmd
+}
+
+func setSecret(cmd *cobra.Command, _ []string) {
+	login, err := cmd.Flags().GetString("login")
+	cmdutil.ExitIfError(err)
+
+	if login == "" {
+		login = viper.GetString("login")
+	}
+	if login == "" {
+		cmdutil.Failed("Unable to determine the login, pass it with --login or run 'jira init' first")
+	}
+
+	secret, err := readSecret()
+	cmdutil.ExitIfError(err)
+
+	if secret == "" {
+		cmdutil.Failed("Empty secret, nothing stored")
+	}
+
+	if err := keyring.Set(api.KeyringService, login, secret); err != nil {
+		cmdutil.Failed("Unable to access the system keyring: %s\nUse the JIRA_API_TOKEN env variable or a .netrc file instead", err)
+	}
+
+	cmdutil.Success("Secret for %q stored in the system keyring", login)
+}
+
+func readSecret() (string, error) {
+	if !isatty.IsTerminal(os.Stdin.Fd()) {
+		in := bufio.NewReader(os.Stdin)
+		secret, err := in.ReadString('\n')
+		if err != nil && secret == "" {
+			return "", fmt.Errorf("unable to read the secret from stdin: %w", err)
+		}
+		return strings.TrimSpace(secret), nil
+	}
+
+	var secret string
+	prompt := &survey.Password{Message: "API token or password"}
+	if err := survey.AskOne(prompt, &secret); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(secret), nil
+}
@@ -0,0 +1,212 @@
+package check
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/api"
+	"github.com/jorres/jira-tui/internal/cmd/ui"
+	"github.com/jorres/jira-tui/internal/cmdcommon"
+	"github.com/jorres/jira-tui/internal/cmdutil"
+	jiraConfig "github.com/jorres/jira-tui/internal/config"
+	"github.com/jorres/jira-tui/internal/query"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+const (
+	helpText = `Check validates the configuration against the Jira server.
+
+It checks that the server is reachable, the project and board exist, the
+configured custom fields are known to the server and the JQL of the tabs
+and saved queries is accepted. The command exits with a non-zero status
+if any of the checks fail.`
+	examples = `$ jira config check
+
+# Check another config file
+$ jira config check -c ~/.config/.jira/work.yml`
+)
+
+// NewCmdCheck is a check command.
+func NewCmdCheck() *cobra.Command {
+	return &cobra.Command{
+		Use:     "check",
+		Short:   "Validate the configuration against the Jira server",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"doctor"},
+		Args:    cobra.NoArgs,
+		Run:     check,
+	}
+}
+
+type checker struct {
+	client *jira.Client
+	failed int
+}
+
+func (c *checker) pass(msg string, args ...any) {
+	fmt.Printf("%s %s\n", color.GreenString("✓"), fmt.Sprintf(msg, args...))
+}
+
+func (c *checker) fail(msg string, args ...any) {
+	c.failed++
+	fmt.Printf("%s %s\n", color.RedString("✗"), fmt.Sprintf(msg, args...))
+}
+
+func (c *checker) skip(msg string, args ...any) {
+	fmt.Printf("%s %s\n", color.YellowString("-"), fmt.Sprintf(msg, args...))
+}
+
+func check(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	c := &checker{client: api.DefaultClient(debug)}
+
+	c.pass("Config file %s", viper.ConfigFileUsed())
+	if c.checkServer() {
+		c.checkProject()
+		c.checkBoard()
+		c.checkCustomFields()
+		c.checkQueries()
+	}
+
+	if c.failed > 0 {
+		fmt.Println()
+		if c.failed == 1 {
+			cmdutil.Failed("1 check failed")
+		}
+		cmdutil.Failed("%d checks failed", c.failed)
+	}
+}
+
+func (c *checker) checkServer() bool {
+	server := viper.GetString("server")
+
+	info, err := c.client.ServerInfo()
+	if err != nil {
+		c.fail("Server %s is not reachable: %s", server, oneLine(err))
+		return false
+	}
+	c.pass("Server %s is reachable (%s %s)", server, info.DeploymentType, info.Version)
+	return true
+}
+
+func (c *checker) checkProject() {
+	key := viper.GetString("project.key")
+	if key == "" {
+		c.fail("No project configured, set project.key")
+		return
+	}
+
+	projects, err := c.client.Project()
+	if err != nil {
+		c.fail("Unable to fetch projects: %s", oneLine(err))
+		return
+	}
+	for _, p := range projects {
+		if strings.EqualFold(p.Key, key) {
+			c.pass("Project %s exists (%s)", p.Key, p.Name)
+			return
+		}
+	}
+	c.fail("Project %s not found or inaccessible", key)
+}
+
+func (c *checker) checkBoard() {
+	id := viper.GetInt("board.id")
+	if id == 0 {
+		c.skip("No board configured")
+		return
+	}
+
+	board, err := c.client.GetBoard(id)
+	if err != nil {
+		c.fail("Board %d not found or inaccessible: %s", id, oneLine(err))
+		return
+	}
+	c.pass("Board %d exists (%s)", board.ID, board.Name)
+}
+
+func (c *checker) checkCustomFields() {
+	configured, err := cmdcommon.GetConfiguredCustomFields()
+	if err != nil {
+		c.fail("Unable to read custom fields: %s", oneLine(err))
+		return
+	}
+	if len(configured) == 0 {
+		c.skip("No custom fields configured")
+		return
+	}
+
+	fields, err := c.client.GetFields()
+	if err != nil {
+		c.fail("Unable to fetch fields: %s", oneLine(err))
+		return
+	}
+
+	missing := missingCustomFields(configured, fields)
+	if len(missing) > 0 {
+		c.fail("Custom fields not found on the server: %s", strings.Join(missing, ", "))
+		return
+	}
+	c.pass("All %d custom fields are known to the server", len(configured))
+}
+
+// missingCustomFields lists the configured fields the server doesn't know about.
+func missingCustomFields(configured []jira.IssueTypeField, fields []*jira.Field) []string {
+	known := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		known[f.ID] = true
+	}
+
+	var missing []string
+	for _, f := range configured {
+		if !known[f.Key] {
+			missing = append(missing, fmt.Sprintf("%s (%s)", f.Name, f.Key))
+		}
+	}
+	return missing
+}
+
+func (c *checker) checkQueries() {
+	var tabs []ui.ListTabConfig
+	if err := viper.UnmarshalKey("ui.list.tabs", &tabs); err != nil {
+		c.fail("Unable to read tabs: %s", oneLine(err))
+		return
+	}
+
+	project := viper.GetString("project.key")
+	for _, tab := range tabs {
+		params := tab.IssueParams
+		params.Project = project
+		if tab.Project != "" {
+			params.Project = tab.Project
+		}
+		q := &query.Issue{}
+		q.SetParams(&params)
+
+		c.checkJQL(fmt.Sprintf("Tab %q", tab.Name), q.Get())
+	}
+
+	for _, q := range jiraConfig.SavedQueries() {
+		c.checkJQL(fmt.Sprintf("Saved query %q", q.Name), q.JQL)
+	}
+}
+
+func (c *checker) checkJQL(name, jql string) {
+	if _, err := api.ProxySearch(c.client, jql, 0, 0); err != nil {
+		c.fail("%s has invalid JQL: %s", name, oneLine(err))
+		return
+	}
+	c.pass("%s has valid JQL", name)
+}
+
+// oneLine keeps the check list readable, Jira errors may span several lines.
+func oneLine(err error) string {
+	return strings.Join(strings.Fields(err.Error()), " ")
+}
@@ -0,0 +1,23 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+func TestMissingCustomFields(t *testing.T) {
+	configured := []jira.IssueTypeField{
+		{Name: "Story Points", Key: "customfield_10010"},
+		{Name: "Team", Key: "customfield_10020"},
+	}
+	fields := []*jira.Field{
+		{ID: "summary", Name: "Summary"},
+		{ID: "customfield_10010", Name: "Story Points", Custom: true},
+	}
+
+	assert.Equal(t, []string{"Team (customfield_10020)"}, missingCustomFields(configured, fields))
+	assert.Empty(t, missingCustomFields(configured[:1], fields))
+}
@@ -0,0 +1,29 @@
+package config
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/jorres/jira-tui/internal/cmd/config/check"
+	"github.com/jorres/jira-tui/internal/cmd/config/path"
+)
+
+const helpText = `Config helps to find and troubleshoot the configuration. See available commands below.`
+
+// NewCmdConfig is a config command.
+func NewCmdConfig() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "config",
+		Short:       "Config helps to find and troubleshoot the configuration",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        config,
+	}
+
+	cmd.AddCommand(path.NewCmdPath(), check.NewCmdCheck())
+
+	return &cmd
+}
+
+func config(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,48 @@
+package path
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/internal/cmdutil"
+	jiraConfig "github.com/jorres/jira-tui/internal/config"
+)
+
+const (
+	helpText = `Path prints the location of the config file in use.
+
+If there is no config file yet, the location 'jira init' creates it at is printed instead.`
+	examples = `$ jira config path
+
+# Edit the config file
+$ $EDITOR "$(jira config path)"`
+)
+
+// NewCmdPath is a path command.
+func NewCmdPath() *cobra.Command {
+	return &cobra.Command{
+		Use:     "path",
+		Short:   "Print the location of the config file",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     path,
+	}
+}
+
+func path(*cobra.Command, []string) {
+	file := viper.ConfigFileUsed()
+	if file == "" {
+		home, err := cmdutil.GetConfigHome()
+		cmdutil.ExitIfError(err)
+
+		file = fmt.Sprintf("%s/%s/%s.%s", home, jiraConfig.Dir, jiraConfig.FileName, jiraConfig.FileType)
+	}
+
+	if !jiraConfig.Exists(file) {
+		cmdutil.Warn("The config file doesn't exist yet, run 'jira init' to create it")
+	}
+	fmt.Println(file)
+}
@@ -0,0 +1,41 @@
+package clear
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jorres/jira-tui/internal/cmdutil"
+	"github.com/jorres/jira-tui/internal/debug"
+)
+
+// NewCmdClear is a debug clear command.
+func NewCmdClear() *cobra.Command {
+	return &cobra.Command{
+		Use:   "clear",
+		Short: "Empty the debug log",
+		Long:  "Clear truncates the debug log and removes the rotated one, if any.",
+		Args:  cobra.NoArgs,
+		Run:   clearLog,
+	}
+}
+
+func clearLog(*cobra.Command, []string) {
+	file, err := debug.Path()
+	cmdutil.ExitIfError(err)
+
+	err = os.Truncate(file, 0)
+	if errors.Is(err, fs.ErrNotExist) {
+		cmdutil.Warn("The debug log doesn't exist yet")
+		return
+	}
+	cmdutil.ExitIfError(err)
+
+	if err := os.Remove(debug.RotatedPath(file)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		cmdutil.ExitIfError(err)
+	}
+
+	cmdutil.Success("Debug log cleared")
+}
@@ -0,0 +1,35 @@
+package debug
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/jorres/jira-tui/internal/cmd/debug/clear"
+	"github.com/jorres/jira-tui/internal/cmd/debug/path"
+	"github.com/jorres/jira-tui/internal/cmd/debug/tail"
+)
+
+const helpText = `Debug inspects and manages the debug log. See available commands below.
+
+The log is written to as the tool runs, more so with --debug, include the relevant
+part of it in bug reports. It is rotated once it reaches 5MB.`
+
+// NewCmdDebug is a debug command.
+func NewCmdDebug() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "debug",
+		Short:       "Debug inspects and manages the debug log",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        debug,
+		// The log is local, there is no need for a token or a config.
+		PersistentPreRun: func(*cobra.Command, []string) {},
+	}
+
+	cmd.AddCommand(path.NewCmdPath(), tail.NewCmdTail(), clear.NewCmdClear())
+
+	return &cmd
+}
+
+func debug(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
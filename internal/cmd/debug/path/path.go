@@ -0,0 +1,34 @@
+package path
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jorres/jira-tui/internal/cmdutil"
+	"github.com/jorres/jira-tui/internal/debug"
+)
+
+const examples = `$ jira debug path
+
+# Search the log
+$ grep -i error "$(jira debug path)"`
+
+// NewCmdPath is a debug path command.
+func NewCmdPath() *cobra.Command {
+	return &cobra.Command{
+		Use:     "path",
+		Short:   "Print the location of the debug log",
+		Long:    "Path prints the location of the debug log, the file may not exist until something is logged.",
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     path,
+	}
+}
+
+func path(*cobra.Command, []string) {
+	file, err := debug.Path()
+	cmdutil.ExitIfError(err)
+
+	fmt.Println(file)
+}
@@ -0,0 +1,120 @@
+package tail
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jorres/jira-tui/internal/cmdutil"
+	"github.com/jorres/jira-tui/internal/debug"
+)
+
+const (
+	helpText = `Tail prints the end of the debug log and keeps printing what is logged, like tail -f.
+
+Run the command that misbehaves with --debug in another terminal to see its log.`
+	examples = `$ jira debug tail
+
+# Only print the last 100 lines
+$ jira debug tail --lines 100 --no-follow`
+
+	pollInterval = 500 * time.Millisecond
+)
+
+// NewCmdTail is a debug tail command.
+func NewCmdTail() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "tail",
+		Short:   "Stream the debug log",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     tail,
+	}
+
+	cmd.Flags().UintP("lines", "n", 20, "Number of lines to print from the end of the log")
+	cmd.Flags().Bool("no-follow", false, "Exit after printing the lines instead of waiting for more")
+
+	return &cmd
+}
+
+func tail(cmd *cobra.Command, _ []string) {
+	lines, err := cmd.Flags().GetUint("lines")
+	cmdutil.ExitIfError(err)
+
+	noFollow, err := cmd.Flags().GetBool("no-follow")
+	cmdutil.ExitIfError(err)
+
+	file, err := debug.Path()
+	cmdutil.ExitIfError(err)
+
+	data, err := os.ReadFile(file)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		cmdutil.ExitIfError(err)
+	}
+	if errors.Is(err, fs.ErrNotExist) && noFollow {
+		cmdutil.Warn("The debug log doesn't exist yet")
+		return
+	}
+	_, _ = os.Stdout.Write(lastLines(data, int(lines)))
+
+	if noFollow {
+		return
+	}
+
+	offset := int64(len(data))
+	for {
+		time.Sleep(pollInterval)
+		offset = follow(file, offset, os.Stdout)
+	}
+}
+
+// follow copies what was written to the log past offset and returns the new
+// offset. A log that shrank was cleared or rotated, it is read from the start.
+func follow(file string, offset int64, w io.Writer) int64 {
+	f, err := os.Open(file)
+	if err != nil {
+		return 0
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return offset
+	}
+	if info.Size() < offset {
+		offset = 0
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return offset
+	}
+
+	n, _ := io.Copy(w, f)
+	return offset + n
+}
+
+// lastLines returns the last n lines of data.
+func lastLines(data []byte, n int) []byte {
+	if n <= 0 {
+		return nil
+	}
+
+	end := len(data)
+	if end > 0 && data[end-1] == '\n' {
+		end--
+	}
+	start := end
+	for range n {
+		i := bytes.LastIndexByte(data[:start], '\n')
+		if i < 0 {
+			return data
+		}
+		start = i
+	}
+	return data[start+1:]
+}
@@ -0,0 +1,44 @@
+package tail
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLastLines(t *testing.T) {
+	data := []byte("one\ntwo\nthree\n")
+
+	assert.Equal(t, "two\nthree\n", string(lastLines(data, 2)))
+	assert.Equal(t, "one\ntwo\nthree\n", string(lastLines(data, 3)))
+	assert.Equal(t, "one\ntwo\nthree\n", string(lastLines(data, 10)))
+	assert.Equal(t, "three", string(lastLines([]byte("one\ntwo\nthree"), 1)))
+	assert.Empty(t, lastLines(data, 0))
+	assert.Empty(t, lastLines(nil, 5))
+}
+
+func TestFollow(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "debug.log")
+	var out bytes.Buffer
+
+	assert.Equal(t, int64(0), follow(file, 0, &out))
+
+	assert.NoError(t, os.WriteFile(file, []byte("one\n"), 0o644))
+	offset := follow(file, 0, &out)
+	assert.Equal(t, int64(4), offset)
+
+	f, err := os.OpenFile(file, os.O_APPEND|os.O_WRONLY, 0o644)
+	assert.NoError(t, err)
+	_, _ = f.WriteString("two\n")
+	_ = f.Close()
+	offset = follow(file, offset, &out)
+	assert.Equal(t, "one\ntwo\n", out.String())
+
+	// The log was cleared and written to again.
+	assert.NoError(t, os.WriteFile(file, []byte("3\n"), 0o644))
+	follow(file, offset, &out)
+	assert.Equal(t, "one\ntwo\n3\n", out.String())
+}
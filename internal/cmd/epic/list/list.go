@@ -29,6 +29,9 @@ $ jira epic list
 $ jira epic list --table
 $ jira epic list <KEY>
 
+# Display epics with their completion
+$ jira epic list --progress
+
 # Display epics or epic issues in a plain table view
 $ jira epic list --table --plain
 $ jira epic list <KEY> --plain
@@ -108,7 +111,7 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 
 			resp, err = client.Search(q.Get(), q.Params().From, q.Params().Limit)
 		} else {
-			resp, err = client.EpicIssues(key, q.Get(), q.Params().From, q.Params().Limit)
+			resp, err = client.EpicIssues(key, q.GetForBoard(), q.Params().From, q.Params().Limit)
 		}
 
 		if err != nil {
@@ -178,6 +181,7 @@ func epicExplorerView(cmd *cobra.Command, flags query.FlagParser, project, proje
 		if err != nil {
 			return nil, 0, err
 		}
+
 		return resp.Issues, resp.Total, nil
 	}()
 	cmdutil.ExitIfError(err)
@@ -222,13 +226,30 @@ func epicExplorerView(cmd *cobra.Command, flags query.FlagParser, project, proje
 	table, err := flags.GetBool("table")
 	cmdutil.ExitIfError(err)
 
+	showProgress, err := flags.GetBool("progress")
+	cmdutil.ExitIfError(err)
+
 	if table || tui.IsDumbTerminal() || tui.IsNotTTY() {
 		list.List(cmd, nil)
 	} else {
+		if showProgress {
+			v.Progress = epicsProgress(client, projectType, epics)
+		}
 		cmdutil.ExitIfError(v.Render())
 	}
 }
 
+func epicsProgress(client *jira.Client, projectType string, epics []*jira.Issue) map[string]api.EpicProgress {
+	s := cmdutil.Info("Fetching epic progress...")
+	defer s.Stop()
+
+	keys := make([]string, 0, len(epics))
+	for _, epic := range epics {
+		keys = append(keys, epic.Key)
+	}
+	return api.ProxyEpicsProgress(client, projectType, keys)
+}
+
 func setFlags(cmd *cobra.Command) {
 	list.SetFlags(cmd)
 	cmd.Flags().Bool("table", false, "Display epics in table view")
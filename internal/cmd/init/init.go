@@ -31,7 +31,7 @@ func NewCmdInit() *cobra.Command {
 		Use:     "init",
 		Short:   "Init initializes jira config",
 		Long:    "Init initializes jira configuration required for the tool to work properly.",
-		Aliases: []string{"initialize", "configure", "config", "setup"},
+		Aliases: []string{"initialize", "configure", "setup"},
 		Run:     initialize,
 	}
 
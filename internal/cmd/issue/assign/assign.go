@@ -25,12 +25,15 @@ const (
 $ jira issue assign ISSUE-1 "Jon Doe"
 
 # Assign to self
-$ jira issue assign ISSUE-1 $(jira me)
+$ jira issue assign ISSUE-1 --to-me
 
 # Assign to default assignee
 $ jira issue assign ISSUE-1 default
 
 # Unassign
+$ jira issue assign ISSUE-1 --unassign
+
+# Or, the short form of the above
 $ jira issue assign ISSUE-1 x`
 
 	maxResults = 100
@@ -44,7 +47,7 @@ $ jira issue assign ISSUE-1 x`
 
 // NewCmdAssign is an assign command.
 func NewCmdAssign() *cobra.Command {
-	return &cobra.Command{
+	cmd := cobra.Command{
 		Use:     "assign ISSUE-KEY ASSIGNEE",
 		Short:   "Assign issue to a user",
 		Long:    helpText,
@@ -56,6 +59,12 @@ ASSIGNEE	Email or display name of the user to assign the issue to`,
 		},
 		Run: assign,
 	}
+
+	cmd.Flags().Bool("to-me", false, "Assign the issue to yourself")
+	cmd.Flags().Bool("unassign", false, "Unassign the issue")
+	cmd.MarkFlagsMutuallyExclusive("to-me", "unassign")
+
+	return &cmd
 }
 
 func assign(cmd *cobra.Command, args []string) {
@@ -71,6 +80,11 @@ func assign(cmd *cobra.Command, args []string) {
 
 	cmdutil.ExitIfError(ac.setIssueKey(project))
 
+	if ac.params.toMe {
+		ac.assignToMe()
+		return
+	}
+
 	if lu != strings.ToLower(optionNone) && lu != "x" && lu != jira.AssigneeDefault {
 		cmdutil.ExitIfError(ac.setAvailableUsers(project))
 		cmdutil.ExitIfError(ac.setAssignee(project))
@@ -118,9 +132,34 @@ func assign(cmd *cobra.Command, args []string) {
 	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(viper.GetString("server"), ac.params.key))
 }
 
+// assignToMe assigns the issue to the currently authenticated user.
+func (ac *assignCmd) assignToMe() {
+	me, err := func() (*jira.Me, error) {
+		s := cmdutil.Info("Fetching current user...")
+		defer s.Stop()
+
+		return ac.client.Me()
+	}()
+	cmdutil.ExitIfError(err)
+
+	u := &jira.User{AccountID: me.AccountID, Name: me.Login, DisplayName: me.Name, Email: me.Email}
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Assigning issue %q to you...", ac.params.key))
+		defer s.Stop()
+
+		return api.ProxyAssignIssue(ac.client, ac.params.key, u, "")
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Issue %q assigned to you", ac.params.key)
+	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(viper.GetString("server"), ac.params.key))
+}
+
 type assignParams struct {
 	key   string
 	user  string
+	toMe  bool
 	debug bool
 }
 
@@ -135,12 +174,25 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *a
 		user = args[1]
 	}
 
+	toMe, err := flags.GetBool("to-me")
+	cmdutil.ExitIfError(err)
+	if toMe && user != "" {
+		cmdutil.ExitIfError(fmt.Errorf("--to-me can't be combined with an assignee"))
+	}
+
+	unassign, err := flags.GetBool("unassign")
+	cmdutil.ExitIfError(err)
+	if unassign {
+		user = "x"
+	}
+
 	debug, err := flags.GetBool("debug")
 	cmdutil.ExitIfError(err)
 
 	return &assignParams{
 		key:   key,
 		user:  user,
+		toMe:  toMe,
 		debug: debug,
 	}
 }
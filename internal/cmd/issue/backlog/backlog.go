@@ -0,0 +1,42 @@
+package backlog
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/jorres/jira-tui/internal/cmdcommon"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+const (
+	helpText = `Backlog moves issues from the board to the backlog of the configured board.`
+	examples = `$ jira issue backlog ISSUE-1
+
+# Move several issues at once
+$ jira issue backlog ISSUE-1 ISSUE-2
+
+# Use a board other than the one from the config
+$ jira issue backlog ISSUE-1 --board 42`
+)
+
+// NewCmdBacklog is an issue backlog command.
+func NewCmdBacklog() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "backlog ISSUE-KEY...",
+		Short:   "Move issues to the backlog",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tKey of the issue to move, eg: ISSUE-1",
+		},
+		Args: cobra.MinimumNArgs(1),
+		Run:  backlog,
+	}
+
+	cmdcommon.SetBoardFlag(&cmd)
+
+	return &cmd
+}
+
+func backlog(cmd *cobra.Command, args []string) {
+	cmdcommon.MoveIssues(cmd, args, "backlog", (*jira.Client).MoveIssueToBacklog)
+}
@@ -0,0 +1,42 @@
+package board
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/jorres/jira-tui/internal/cmdcommon"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+const (
+	helpText = `Board moves issues from the backlog to the configured board.`
+	examples = `$ jira issue board ISSUE-1
+
+# Move several issues at once
+$ jira issue board ISSUE-1 ISSUE-2
+
+# Use a board other than the one from the config
+$ jira issue board ISSUE-1 --board 42`
+)
+
+// NewCmdBoard is an issue board command.
+func NewCmdBoard() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "board ISSUE-KEY...",
+		Short:   "Move issues from the backlog to the board",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tKey of the issue to move, eg: ISSUE-1",
+		},
+		Args: cobra.MinimumNArgs(1),
+		Run:  board,
+	}
+
+	cmdcommon.SetBoardFlag(&cmd)
+
+	return &cmd
+}
+
+func board(cmd *cobra.Command, args []string) {
+	cmdcommon.MoveIssues(cmd, args, "board", (*jira.Client).MoveIssueToBoard)
+}
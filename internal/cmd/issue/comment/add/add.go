@@ -35,6 +35,9 @@ $ jira issue comment add ISSUE-1 --template -
 # Or, use pipe to read input directly from standard input
 $ echo "Comment from stdin" | jira issue comment add ISSUE-1
 
+# Add an internal note to a service desk request, hidden from the customer
+$ jira issue comment add ISSUE-1 "Waiting on the vendor" --internal
+
 # Positional argument takes precedence over the template flag
 # The example below will add "comment from arg" as a comment
 $ jira issue comment add ISSUE-1 "comment from arg" --template /path/to/template.tmpl`
@@ -57,7 +60,8 @@ func NewCmdCommentAdd() *cobra.Command {
 	cmd.Flags().Bool("web", false, "Open issue in web browser after adding comment")
 	cmd.Flags().StringP("template", "T", "", "Path to a file to read comment body from")
 	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
-	cmd.Flags().Bool("internal", false, "Make comment internal")
+	cmd.Flags().Bool("internal", false, `Make comment internal, visible to service desk agents only.
+Ignored by projects other than Jira Service Management ones`)
 
 	return &cmd
 }
@@ -116,7 +120,7 @@ func add(cmd *cobra.Command, args []string) {
 			}
 			return client.AddIssueCommentV2(ac.params.issueKey, ac.params.body, ac.params.internal)
 		} else {
-			adfDoc, err := translator.TranslateToADF([]byte(ac.params.body))
+			adfDoc, err := editing.TranslateToADF(translator, ac.params.body)
 			if err != nil {
 				cmdutil.ExitIfError(fmt.Errorf("failed to convert your new comment to ADF: %w", err))
 			}
@@ -0,0 +1,73 @@
+package create
+
+import (
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/internal/cmdcommon"
+	"github.com/jorres/jira-tui/internal/cmdutil"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// useComponentAssignee tells if the default assignee of the issue component
+// should be offered, it is opt-in as it costs extra API calls.
+func useComponentAssignee() bool {
+	return viper.GetBool("issue.use_component_assignee")
+}
+
+// componentAssignee returns the default assignee of the first component, or
+// nil if it is not set or cannot be fetched.
+func componentAssignee(client *jira.Client, project string, components []string) *jira.User {
+	if len(components) == 0 {
+		return nil
+	}
+
+	s := cmdutil.Info("Fetching component assignee...")
+	defer s.Stop()
+
+	all, err := client.ProjectComponents(project)
+	if err != nil {
+		return nil
+	}
+	name := strings.TrimSpace(components[0])
+	for _, c := range all {
+		if !strings.EqualFold(c.Name, name) {
+			continue
+		}
+		component, err := client.GetComponent(c.ID)
+		if err != nil {
+			return nil
+		}
+		return component.DefaultAssignee()
+	}
+	return nil
+}
+
+// askComponentAssignee pre-fills the assignee prompt with the component
+// default assignee and returns the user key to assign to.
+func askComponentAssignee(client *jira.Client, project string, user *jira.User, noInput bool) string {
+	if noInput {
+		return cmdcommon.GetUserKeyForConfiguredInstallation(user)
+	}
+
+	var ans string
+	prompt := &survey.Input{
+		Message: "Assignee",
+		Default: user.DisplayName,
+		Help:    "Default assignee of the component, clear to leave the issue unassigned",
+	}
+	if err := survey.AskOne(prompt, &ans); err != nil {
+		cmdutil.ExitIfError(err)
+	}
+
+	switch ans = strings.TrimSpace(ans); ans {
+	case "":
+		return ""
+	case user.DisplayName:
+		return cmdcommon.GetUserKeyForConfiguredInstallation(user)
+	default:
+		return cmdcommon.GetRelevantUser(client, project, ans)
+	}
+}
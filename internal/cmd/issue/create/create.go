@@ -45,9 +45,20 @@ $ echo "Description from stdin" | jira issue create -s"Summary" -tTask
 
 # For issue description, the flag --body/-b takes precedence over the --template flag
 # The example below will add "Body from flag" as an issue description
-$ jira issue create -tTask -sSummary -b"Body from flag" --template /path/to/template.tpl`
+$ jira issue create -tTask -sSummary -b"Body from flag" --template /path/to/template.tpl
 
-	flagRaw = "raw"
+# Create one or many issues described in a YAML/JSON file
+$ jira issue create --from-file issues.yaml
+
+# Create an issue on behalf of someone else
+$ jira issue create -tBug -s"New Bug" --reporter jane@example.com
+
+# Create an issue blocking another one
+$ jira issue create -tBug -s"New Bug" --link blocks:ISSUE-1`
+
+	flagRaw      = "raw"
+	flagFromFile = "from-file"
+	flagLink     = "link"
 )
 
 // NewCmdCreate is a create command.
@@ -61,6 +72,10 @@ func NewCmdCreate() *cobra.Command {
 	}
 
 	cmd.Flags().Bool(flagRaw, false, "Print output in JSON format")
+	cmd.Flags().String(flagFromFile, "", `Read issue spec(s) from a YAML or JSON file.
+The file may contain a single issue or a list of issues.`)
+	cmd.Flags().StringArray(flagLink, []string{}, `Link the created issue to another one, eg: blocks:ISSUE-1.
+Can be repeated, the link type is one of the issue link type names.`)
 
 	return &cmd
 }
@@ -73,8 +88,6 @@ func SetFlags(cmd *cobra.Command) {
 func create(cmd *cobra.Command, _ []string) {
 	server := viper.GetString("server")
 	project := viper.GetString("project.key")
-	projectType := viper.GetString("project.type")
-	installation := viper.GetString("installation")
 
 	params := parseFlags(cmd.Flags())
 	client := api.DefaultClient(params.Debug)
@@ -83,6 +96,28 @@ func create(cmd *cobra.Command, _ []string) {
 		params: params,
 	}
 
+	linkSpecs, err := cmd.Flags().GetStringArray(flagLink)
+	cmdutil.ExitIfError(err)
+	links, err := parseLinks(project, linkSpecs)
+	cmdutil.ExitIfError(err)
+
+	// Link types are verified upfront, so that a typo doesn't leave an unlinked issue behind.
+	if len(links) > 0 {
+		linkTypes, err := func() ([]*jira.IssueLinkType, error) {
+			s := cmdutil.Info("Fetching link types. Please wait...")
+			defer s.Stop()
+
+			return client.GetIssueLinkTypes()
+		}()
+		cmdutil.ExitIfError(err)
+		cmdutil.ExitIfError(resolveLinkTypes(links, linkTypes))
+	}
+
+	if fromFile, _ := cmd.Flags().GetString(flagFromFile); fromFile != "" {
+		cc.createFromFile(cmd, fromFile, links)
+		return
+	}
+
 	if cc.isNonInteractive() || cc.params.NoInput || tui.IsDumbTerminal() {
 		cc.params.NoInput = true
 
@@ -104,39 +139,21 @@ func create(cmd *cobra.Command, _ []string) {
 	params.Reporter = cmdcommon.GetRelevantUser(client, project, params.Reporter)
 	params.Assignee = cmdcommon.GetRelevantUser(client, project, params.Assignee)
 
+	if params.Assignee == "" && useComponentAssignee() {
+		if user := componentAssignee(client, project, params.Components); user != nil {
+			params.Assignee = askComponentAssignee(client, project, user, params.NoInput)
+		}
+	}
+
+	if params.NoInput {
+		cmdutil.ExitIfError(cc.checkRequiredFields(project, params))
+	}
+
 	issue, err := func() (*jira.CreateResponse, error) {
 		s := cmdutil.Info("Creating an issue...")
 		defer s.Stop()
 
-		cr := jira.CreateRequest{
-			Project:          project,
-			IssueType:        params.IssueType,
-			ParentIssueKey:   params.ParentIssueKey,
-			Summary:          params.Summary,
-			Body:             params.Body,
-			Reporter:         params.Reporter,
-			Assignee:         params.Assignee,
-			Priority:         params.Priority,
-			Labels:           params.Labels,
-			Components:       params.Components,
-			FixVersions:      params.FixVersions,
-			AffectsVersions:  params.AffectsVersions,
-			OriginalEstimate: params.OriginalEstimate,
-			CustomFields:     params.CustomFields,
-			EpicField:        viper.GetString("epic.link"),
-		}
-		cr.ForProjectType(projectType)
-		cr.ForInstallationType(installation)
-		if configuredCustomFields, err := cmdcommon.GetConfiguredCustomFields(); err == nil {
-			cmdcommon.ValidateCustomFields(cr.CustomFields, configuredCustomFields)
-			cr.WithCustomFields(configuredCustomFields)
-		}
-
-		if handle := cmdutil.GetSubtaskHandle(params.IssueType, cc.issueTypes); handle != "" {
-			cr.SubtaskField = handle
-		}
-
-		return client.CreateV2(&cr)
+		return cc.createIssue(params)
 	}()
 
 	cmdutil.ExitIfError(err)
@@ -147,17 +164,103 @@ func create(cmd *cobra.Command, _ []string) {
 		jsonData, err := json.Marshal(issue)
 		cmdutil.ExitIfError(err)
 		fmt.Println(string(jsonData))
-		return
+	} else {
+		cmdutil.Success("Issue created\n%s", cmdutil.GenerateServerBrowseURL(server, issue.Key))
 	}
 
-	cmdutil.Success("Issue created\n%s", cmdutil.GenerateServerBrowseURL(server, issue.Key))
+	// The issue exists at this point, a failed link doesn't fail the command.
+	if err := cc.addLinks(issue.Key, links); err != nil {
+		cmdutil.Warn("%s", err)
+	}
 
-	if web, _ := cmd.Flags().GetBool("web"); web {
+	if web, _ := cmd.Flags().GetBool("web"); web && !jsonFlag {
 		err := cmdutil.Navigate(server, issue.Key)
 		cmdutil.ExitIfError(err)
 	}
 }
 
+// createFromFile creates every issue described in the spec file, printing each new key.
+// Each issue gets the links given with --link.
+func (cc *createCmd) createFromFile(cmd *cobra.Command, path string, links []issueLink) {
+	project := viper.GetString("project.key")
+
+	specs, err := readSpecFile(path)
+	cmdutil.ExitIfError(err)
+
+	cmdutil.ExitIfError(cc.setIssueTypes())
+
+	jsonFlag, err := cmd.Flags().GetBool(flagRaw)
+	cmdutil.ExitIfError(err)
+
+	for i, spec := range specs {
+		params := spec.toParams(cc.params.Debug)
+		if params.ParentIssueKey != "" {
+			params.ParentIssueKey = cmdutil.GetJiraIssueKey(project, params.ParentIssueKey)
+		}
+		params.Reporter = cmdcommon.GetRelevantUser(cc.client, project, params.Reporter)
+		params.Assignee = cmdcommon.GetRelevantUser(cc.client, project, params.Assignee)
+
+		issue, err := func() (*jira.CreateResponse, error) {
+			s := cmdutil.Info(fmt.Sprintf("Creating issue %d of %d...", i+1, len(specs)))
+			defer s.Stop()
+
+			return cc.createIssue(params)
+		}()
+		if err != nil {
+			cmdutil.Failed("Failed to create issue #%d (%s): %s", i+1, spec.Summary, err)
+		}
+
+		if jsonFlag {
+			jsonData, err := json.Marshal(issue)
+			cmdutil.ExitIfError(err)
+			fmt.Println(string(jsonData))
+		} else {
+			fmt.Println(issue.Key)
+		}
+
+		if err := cc.addLinks(issue.Key, links); err != nil {
+			cmdutil.Warn("%s", err)
+		}
+	}
+}
+
+// createIssue builds the create request from params and sends it.
+func (cc *createCmd) createIssue(params *cmdcommon.CreateParams) (*jira.CreateResponse, error) {
+	cr := jira.CreateRequest{
+		Project:          viper.GetString("project.key"),
+		IssueType:        params.IssueType,
+		ParentIssueKey:   params.ParentIssueKey,
+		Summary:          params.Summary,
+		Body:             params.Body,
+		Reporter:         params.Reporter,
+		Assignee:         params.Assignee,
+		Priority:         params.Priority,
+		Labels:           params.Labels,
+		Components:       params.Components,
+		FixVersions:      params.FixVersions,
+		AffectsVersions:  params.AffectsVersions,
+		OriginalEstimate: params.OriginalEstimate,
+		CustomFields:     params.CustomFields,
+		EpicField:        viper.GetString("epic.link"),
+	}
+	cr.ForProjectType(viper.GetString("project.type"))
+	cr.ForInstallationType(viper.GetString("installation"))
+	if configuredCustomFields, err := cmdcommon.GetConfiguredCustomFields(); err == nil {
+		cmdcommon.ValidateCustomFields(cr.CustomFields, configuredCustomFields)
+		cr.WithCustomFields(configuredCustomFields)
+	}
+
+	if handle := cmdutil.GetSubtaskHandle(params.IssueType, cc.issueTypes); handle != "" {
+		cr.SubtaskField = handle
+	}
+
+	resp, err := cc.client.CreateV2(&cr)
+	if err != nil {
+		return nil, reporterError(err, cr.Reporter)
+	}
+	return resp, nil
+}
+
 type createCmd struct {
 	client     *jira.Client
 	issueTypes []*jira.IssueType
@@ -0,0 +1,81 @@
+package create
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jorres/jira-tui/internal/cmdutil"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// issueLink is a link requested with `--link TYPE:ISSUE-KEY`, it is added
+// right after the issue is created.
+type issueLink struct {
+	linkType string
+	key      string
+}
+
+func parseLinks(project string, specs []string) ([]issueLink, error) {
+	links := make([]issueLink, 0, len(specs))
+	for _, spec := range specs {
+		idx := strings.LastIndex(spec, ":")
+		if idx <= 0 || idx == len(spec)-1 {
+			return nil, fmt.Errorf("invalid link %q, expected TYPE:ISSUE-KEY, eg: blocks:ISSUE-1", spec)
+		}
+		links = append(links, issueLink{
+			linkType: strings.TrimSpace(spec[:idx]),
+			key:      cmdutil.GetJiraIssueKey(project, strings.TrimSpace(spec[idx+1:])),
+		})
+	}
+	return links, nil
+}
+
+// resolveLinkTypes replaces the link types with the names Jira knows them by,
+// the match is case-insensitive.
+func resolveLinkTypes(links []issueLink, linkTypes []*jira.IssueLinkType) error {
+	all := make([]string, 0, len(linkTypes))
+	for _, t := range linkTypes {
+		all = append(all, fmt.Sprintf("'%s'", t.Name))
+	}
+
+	for n, l := range links {
+		var lt *jira.IssueLinkType
+		for _, t := range linkTypes {
+			if strings.EqualFold(t.Name, l.linkType) {
+				lt = t
+				break
+			}
+		}
+		if lt == nil {
+			return fmt.Errorf(
+				"invalid issue link type %q\nAvailable issue link types are: %s",
+				l.linkType, strings.Join(all, ", "),
+			)
+		}
+		links[n].linkType = lt.Name
+	}
+	return nil
+}
+
+// addLinks links the created issue to the requested ones, the created issue is
+// the inward one like with `jira issue link`.
+func (cc *createCmd) addLinks(key string, links []issueLink) error {
+	var failed []string
+	for _, l := range links {
+		err := func() error {
+			s := cmdutil.Info(fmt.Sprintf("Linking %s to %s...", key, l.key))
+			defer s.Stop()
+
+			return cc.client.LinkIssue(key, l.key, l.linkType)
+		}()
+		if err != nil {
+			cmdutil.Fail("Unable to link %s to %s as %q: %s", key, l.key, l.linkType, err)
+			failed = append(failed, l.key)
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("issue %s was created, but linking failed for: %s", key, strings.Join(failed, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package create
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+func TestParseAndResolveLinks(t *testing.T) {
+	links, err := parseLinks("TEST", []string{"blocks:TEST-1", "Relates: 2"})
+	assert.NoError(t, err)
+	assert.Equal(t, []issueLink{
+		{linkType: "blocks", key: "TEST-1"},
+		{linkType: "Relates", key: "TEST-2"},
+	}, links)
+
+	_, err = parseLinks("TEST", []string{"TEST-1"})
+	assert.Error(t, err)
+	_, err = parseLinks("TEST", []string{"blocks:"})
+	assert.Error(t, err)
+
+	linkTypes := []*jira.IssueLinkType{
+		{Name: "Blocks", Inward: "is blocked by", Outward: "blocks"},
+		{Name: "Relates", Inward: "relates to", Outward: "relates to"},
+	}
+	assert.NoError(t, resolveLinkTypes(links, linkTypes))
+	assert.Equal(t, "Blocks", links[0].linkType)
+
+	err = resolveLinkTypes([]issueLink{{linkType: "duplicates", key: "TEST-1"}}, linkTypes)
+	assert.EqualError(t, err, "invalid issue link type \"duplicates\"\nAvailable issue link types are: 'Blocks', 'Relates'")
+}
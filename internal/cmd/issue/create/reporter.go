@@ -0,0 +1,27 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// reporterError explains why Jira refused the reporter. Setting the reporter needs
+// the "Modify Reporter" permission, and the field has to be on the create screen,
+// otherwise Jira only says the field can't be set.
+func reporterError(err error, reporter string) error {
+	var resp *jira.ErrUnexpectedResponse
+	if reporter == "" || !errors.As(err, &resp) {
+		return err
+	}
+	msg, ok := resp.Body.Errors["reporter"]
+	if !ok {
+		return err
+	}
+	return fmt.Errorf(
+		"unable to set the reporter: %s\nFiling issues on behalf of others needs the \"Modify Reporter\" project permission "+
+			"and the Reporter field on the create screen, ask your Jira admin or create the issue without --reporter",
+		msg,
+	)
+}
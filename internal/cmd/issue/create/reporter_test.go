@@ -0,0 +1,32 @@
+package create
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+func TestReporterError(t *testing.T) {
+	rejected := &jira.ErrUnexpectedResponse{
+		StatusCode: 400,
+		Body: jira.Errors{Errors: map[string]string{
+			"reporter": "Field 'reporter' cannot be set. It is not on the appropriate screen, or unknown.",
+		}},
+	}
+
+	err := reporterError(rejected, "5b10ac8d82e05b22cc7d4ef5")
+	assert.ErrorContains(t, err, "unable to set the reporter: Field 'reporter' cannot be set.")
+	assert.ErrorContains(t, err, `"Modify Reporter"`)
+
+	// Without a reporter the error can't be about it.
+	assert.Same(t, rejected, reporterError(rejected, ""))
+
+	other := &jira.ErrUnexpectedResponse{StatusCode: 400, Body: jira.Errors{Errors: map[string]string{"summary": "required"}}}
+	assert.Same(t, other, reporterError(other, "5b10ac8d82e05b22cc7d4ef5"))
+
+	plain := errors.New("timeout")
+	assert.Same(t, plain, reporterError(plain, "5b10ac8d82e05b22cc7d4ef5"))
+}
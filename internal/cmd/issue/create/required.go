@@ -0,0 +1,62 @@
+package create
+
+import (
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/internal/cmdcommon"
+	"github.com/jorres/jira-tui/internal/debug"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// checkRequiredFields fails with the names of the fields the create screen of
+// the issue type requires but params leave empty, instead of a bare 400 from
+// Jira. Servers that don't serve the create metadata at all are not checked.
+func (cc *createCmd) checkRequiredFields(project string, params *cmdcommon.CreateParams) error {
+	fields, err := cc.client.GetCreateMetaIssueTypeFields(project, params.IssueType)
+	if err != nil {
+		debug.Debug("skipping the required fields check:", err)
+		return nil
+	}
+
+	configured, _ := cmdcommon.GetConfiguredCustomFields()
+	if missing := missingCreateFields(fields, params, configured); len(missing) > 0 {
+		return cmdcommon.MissingRequiredFieldsError(missing)
+	}
+	return nil
+}
+
+// missingCreateFields returns the names of the required fields params don't set.
+// Fields Jira fills with a default value are not required from the user.
+func missingCreateFields(fields map[string]jira.IssueTypeField, params *cmdcommon.CreateParams, configured []jira.IssueTypeField) []string {
+	required := make(map[string]string)
+	for key, f := range fields {
+		if f.Required && !f.HasDefaultValue {
+			required[key] = f.Name
+		}
+	}
+
+	set := map[string]bool{
+		"project":      true,
+		"issuetype":    true,
+		"summary":      params.Summary != "",
+		"description":  params.Body != "",
+		"priority":     params.Priority != "",
+		"reporter":     params.Reporter != "",
+		"assignee":     params.Assignee != "",
+		"parent":       params.ParentIssueKey != "",
+		"labels":       len(params.Labels) > 0,
+		"components":   len(params.Components) > 0,
+		"fixVersions":  len(params.FixVersions) > 0,
+		"versions":     len(params.AffectsVersions) > 0,
+		"timetracking": params.OriginalEstimate != "",
+	}
+	// The parent of an issue in a classic project goes to the epic link field
+	if epicLink := viper.GetString("epic.link"); epicLink != "" {
+		set[epicLink] = params.ParentIssueKey != ""
+	}
+	for _, key := range cmdcommon.CustomFieldKeys(params.CustomFields, configured) {
+		set[key] = true
+	}
+
+	return cmdcommon.MissingRequiredFields(required, func(key string) bool { return set[key] })
+}
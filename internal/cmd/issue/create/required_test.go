@@ -0,0 +1,36 @@
+package create
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/jira-tui/internal/cmdcommon"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+func TestMissingCreateFields(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	fields := map[string]jira.IssueTypeField{
+		"summary":           {Name: "Summary", Required: true},
+		"issuetype":         {Name: "Issue Type", Required: true},
+		"reporter":          {Name: "Reporter", Required: true, HasDefaultValue: true},
+		"components":        {Name: "Component/s", Required: true},
+		"labels":            {Name: "Labels"},
+		"customfield_10010": {Name: "Team", Required: true},
+	}
+	configured := []jira.IssueTypeField{{Name: "Team", Key: "customfield_10010"}}
+
+	missing := missingCreateFields(fields, &cmdcommon.CreateParams{IssueType: "Task"}, configured)
+	assert.Equal(t, []string{"Component/s", "Summary", "Team"}, missing)
+
+	missing = missingCreateFields(fields, &cmdcommon.CreateParams{
+		IssueType:    "Task",
+		Summary:      "Broken build",
+		Components:   []string{"CI"},
+		CustomFields: map[string]string{"team": "Platform"},
+	}, configured)
+	assert.Empty(t, missing)
+}
@@ -0,0 +1,88 @@
+package create
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/jorres/jira-tui/internal/cmdcommon"
+)
+
+// issueSpec is a single issue definition read from a --from-file spec.
+// Since JSON is a subset of YAML, the same tags cover both formats.
+type issueSpec struct {
+	Summary          string            `yaml:"summary"`
+	Type             string            `yaml:"type"`
+	Parent           string            `yaml:"parent"`
+	Description      string            `yaml:"description"`
+	Priority         string            `yaml:"priority"`
+	Reporter         string            `yaml:"reporter"`
+	Assignee         string            `yaml:"assignee"`
+	Labels           []string          `yaml:"labels"`
+	Components       []string          `yaml:"components"`
+	FixVersions      []string          `yaml:"fixVersions"`
+	AffectsVersions  []string          `yaml:"affectsVersions"`
+	OriginalEstimate string            `yaml:"originalEstimate"`
+	Custom           map[string]string `yaml:"custom"`
+}
+
+// readSpecFile reads one or many issue specs from a YAML or JSON file.
+// The file may either hold a single issue object or a list of them.
+func readSpecFile(path string) ([]issueSpec, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(b, &root); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+	if len(root.Content) == 0 {
+		return nil, fmt.Errorf("%s does not contain any issues", path)
+	}
+
+	var specs []issueSpec
+
+	doc := root.Content[0]
+	if doc.Kind == yaml.SequenceNode {
+		err = doc.Decode(&specs)
+	} else {
+		var spec issueSpec
+		err = doc.Decode(&spec)
+		specs = append(specs, spec)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+
+	for i, s := range specs {
+		if s.Summary == "" || s.Type == "" {
+			return nil, fmt.Errorf("issue #%d in %s: `summary` and `type` are mandatory", i+1, path)
+		}
+	}
+
+	return specs, nil
+}
+
+// toParams converts a spec into the same params the interactive flow builds.
+func (s issueSpec) toParams(debug bool) *cmdcommon.CreateParams {
+	return &cmdcommon.CreateParams{
+		IssueType:        s.Type,
+		ParentIssueKey:   s.Parent,
+		Summary:          s.Summary,
+		Body:             s.Description,
+		Priority:         s.Priority,
+		Reporter:         s.Reporter,
+		Assignee:         s.Assignee,
+		Labels:           s.Labels,
+		Components:       s.Components,
+		FixVersions:      s.FixVersions,
+		AffectsVersions:  s.AffectsVersions,
+		OriginalEstimate: s.OriginalEstimate,
+		CustomFields:     s.Custom,
+		NoInput:          true,
+		Debug:            debug,
+	}
+}
@@ -0,0 +1,88 @@
+package create
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadSpecFile(t *testing.T) {
+	cases := []struct {
+		name     string
+		content  string
+		expected []issueSpec
+		err      string
+	}{
+		{
+			name: "single yaml issue",
+			content: `summary: Login fails
+type: Bug
+labels: [auth, urgent]
+custom:
+  story-points: "3"`,
+			expected: []issueSpec{{
+				Summary: "Login fails",
+				Type:    "Bug",
+				Labels:  []string{"auth", "urgent"},
+				Custom:  map[string]string{"story-points": "3"},
+			}},
+		},
+		{
+			name: "yaml list",
+			content: `- summary: First
+  type: Task
+- summary: Second
+  type: Story
+  parent: TEST-1`,
+			expected: []issueSpec{
+				{Summary: "First", Type: "Task"},
+				{Summary: "Second", Type: "Story", Parent: "TEST-1"},
+			},
+		},
+		{
+			name:     "json list",
+			content:  `[{"summary": "First", "type": "Task", "fixVersions": ["v1.0"]}]`,
+			expected: []issueSpec{{Summary: "First", Type: "Task", FixVersions: []string{"v1.0"}}},
+		},
+		{
+			name:    "empty file",
+			content: "",
+			err:     "does not contain any issues",
+		},
+		{
+			name:    "invalid yaml",
+			content: "summary: [unclosed",
+			err:     "unable to parse",
+		},
+		{
+			name:    "wrong shape",
+			content: "summary:\n  nested: map",
+			err:     "unable to parse",
+		},
+		{
+			name:    "missing type",
+			content: "- summary: First\n  type: Task\n- summary: Second",
+			err:     "issue #2 in",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "issues.yml")
+			assert.NoError(t, os.WriteFile(path, []byte(tc.content), 0o600))
+
+			specs, err := readSpecFile(path)
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, specs)
+		})
+	}
+
+	_, err := readSpecFile(filepath.Join(t.TempDir(), "missing.yml"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,248 @@
+package edit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/fatih/color"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	extast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+
+	"github.com/jorres/md2adf-translator/adf"
+	"github.com/jorres/md2adf-translator/adf2md"
+	"github.com/jorres/md2adf-translator/md2adf"
+
+	"github.com/jorres/jira-tui/internal/editing"
+)
+
+// diffContext is the number of unchanged lines shown around a change.
+const diffContext = 3
+
+// unifiedDiff returns a unified diff of the texts, or an empty string if they
+// only differ in leading and trailing whitespace.
+func unifiedDiff(name, old, new string) string {
+	return labeledDiff(name+" (current)", name+" (edited)", old, new)
+}
+
+func labeledDiff(from, to, old, new string) string {
+	old, new = strings.TrimSpace(old), strings.TrimSpace(new)
+	if old == new {
+		return ""
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(old),
+		B:        difflib.SplitLines(new),
+		FromFile: from,
+		ToFile:   to,
+		Context:  diffContext,
+	})
+	if err != nil {
+		return ""
+	}
+	return diff
+}
+
+// colorizeDiff colors added lines green and removed lines red.
+func colorizeDiff(diff string) string {
+	lines := strings.SplitAfter(diff, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			lines[i] = color.New(color.Bold).Sprint(line)
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = color.CyanString("%s", line)
+		case strings.HasPrefix(line, "+"):
+			lines[i] = color.GreenString("%s", line)
+		case strings.HasPrefix(line, "-"):
+			lines[i] = color.RedString("%s", line)
+		}
+	}
+	return strings.Join(lines, "")
+}
+
+// editDiff describes the changes to the description and the comments. Comments
+// are matched to the original ones by position.
+func editDiff(originalBody, body string, cleared bool, originalComments []string, comments []editComment) string {
+	var out strings.Builder
+
+	// An empty body means the description is kept as is, unless it was cleared.
+	if body != "" || cleared {
+		out.WriteString(unifiedDiff("description", originalBody, body))
+	}
+	for i, c := range comments {
+		if i >= len(originalComments) {
+			break
+		}
+		out.WriteString(unifiedDiff(fmt.Sprintf("comment %s", c.id), originalComments[i], c.body))
+	}
+
+	return out.String()
+}
+
+// showDiff prints the changes and, unless prompts are disabled, asks whether
+// to submit them.
+func showDiff(diff string, noInput bool) bool {
+	if diff == "" {
+		fmt.Println("No changes to the description or comments")
+		return true
+	}
+
+	fmt.Print(colorizeDiff(diff))
+	if noInput {
+		return true
+	}
+
+	var submit bool
+	prompt := &survey.Confirm{
+		Message: "Submit these changes?",
+		Default: true,
+	}
+	if err := survey.AskOne(prompt, &submit); err != nil {
+		return false
+	}
+	return submit
+}
+
+// conversionDiff translates the markdown to ADF and back, and returns what the
+// translator would drop or mangle on the way. Changes in whitespace and in the
+// way emphasis or tables are written are not reported, see sameText.
+func conversionDiff(name, body string, translator *md2adf.Translator, reverse *adf2md.Translator) (string, error) {
+	if body == "" {
+		return "", nil
+	}
+
+	doc, err := editing.TranslateToADF(translator, body)
+	if err != nil {
+		return "", err
+	}
+	// Round trip through JSON so the attributes have the types the reverse
+	// translator expects from the API, heading levels are float64 there.
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	var node adf.ADFNode
+	if err := json.Unmarshal(raw, &node); err != nil {
+		return "", err
+	}
+	sent := reverse.Translate(&node)
+
+	if sameText(body, sent) {
+		return "", nil
+	}
+	return labeledDiff(name+" (edited)", name+" (as sent)", body, sent), nil
+}
+
+// strikethrough matches the way the translator writes struck text back.
+var strikethrough = regexp.MustCompile(`-~(.+?)~-`)
+
+// sameText compares the structure and the text of the two markdown documents,
+// ignoring how they spell it: emphasis markers, table padding, list bullets
+// and numbering. Anything the translator dropped or flattened, like a rule, a
+// list or a table, still tells them apart.
+func sameText(body, sent string) bool {
+	return markupOutline(body) == markupOutline(strikethrough.ReplaceAllString(sent, "~~$1~~"))
+}
+
+// markupOutline parses the markdown and lists its nodes along with their text.
+// Emphasis is left out, the translator bolds table headers on its own.
+func markupOutline(md string) string {
+	source := []byte(md)
+	doc := goldmark.New(goldmark.WithExtensions(extension.GFM)).Parser().Parse(text.NewReader(source))
+
+	var out strings.Builder
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		switch n.Kind() {
+		case ast.KindEmphasis, extast.KindStrikethrough:
+			return ast.WalkContinue, nil
+		}
+		if !entering {
+			out.WriteString(")")
+			return ast.WalkContinue, nil
+		}
+
+		out.WriteString(n.Kind().String())
+		switch n := n.(type) {
+		case *ast.Heading:
+			fmt.Fprintf(&out, "%d", n.Level)
+		case *ast.List:
+			fmt.Fprintf(&out, "%t", n.IsOrdered())
+		case *ast.Link:
+			fmt.Fprintf(&out, "<%s>", n.Destination)
+		case *ast.AutoLink:
+			fmt.Fprintf(&out, "<%s>", n.URL(source))
+		case *extast.TaskCheckBox:
+			fmt.Fprintf(&out, "%t", n.IsChecked)
+		case *ast.Text:
+			out.WriteString(strings.Join(strings.Fields(string(n.Segment.Value(source))), " "))
+		case *ast.String:
+			out.WriteString(strings.Join(strings.Fields(string(n.Value)), " "))
+		}
+		out.WriteString("(")
+		if n.Type() == ast.TypeBlock {
+			lines := n.Lines()
+			for i := 0; n.IsRaw() && i < lines.Len(); i++ {
+				line := lines.At(i)
+				out.WriteString(strings.TrimSpace(string(line.Value(source))))
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	return out.String()
+}
+
+// lossDiff describes what converting the description and the comments to ADF
+// would change.
+func lossDiff(body string, comments []editComment, translator *md2adf.Translator, reverse *adf2md.Translator) (string, error) {
+	var out strings.Builder
+
+	diff, err := conversionDiff("description", body, translator, reverse)
+	if err != nil {
+		return "", err
+	}
+	out.WriteString(diff)
+
+	for _, c := range comments {
+		diff, err := conversionDiff(fmt.Sprintf("comment %s", c.id), c.body, translator, reverse)
+		if err != nil {
+			return "", err
+		}
+		out.WriteString(diff)
+	}
+
+	return out.String(), nil
+}
+
+// confirmLoss warns about the content that wouldn't survive the conversion and,
+// unless prompts are disabled, asks whether to send it anyway.
+func confirmLoss(diff string, noInput bool) bool {
+	if diff == "" {
+		return true
+	}
+
+	fmt.Fprintln(os.Stderr, color.YellowString("Some of the content is not supported by the markdown to ADF translator "+
+		"and would be lost or changed:"))
+	fmt.Fprint(os.Stderr, colorizeDiff(diff))
+	if noInput {
+		return true
+	}
+
+	var submit bool
+	prompt := &survey.Confirm{
+		Message: "Send it anyway?",
+		Default: false,
+	}
+	if err := survey.AskOne(prompt, &submit); err != nil {
+		return false
+	}
+	return submit
+}
@@ -0,0 +1,81 @@
+package edit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/md2adf-translator/md2adf"
+
+	"github.com/jorres/jira-tui/internal/editing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	assert.Empty(t, unifiedDiff("description", "Some text\n", "Some text"))
+
+	diff := unifiedDiff("description", "first\nsecond\nthird", "first\n2nd\nthird")
+	assert.Equal(t, `--- description (current)
++++ description (edited)
+@@ -1,3 +1,3 @@
+ first
+-second
++2nd
+ third
+`, diff)
+}
+
+func TestEditDiff(t *testing.T) {
+	comments := []editComment{{id: "10001", body: "unchanged"}, {id: "10002", body: "edited"}}
+
+	diff := editDiff("body", "", false, []string{"unchanged", "original"}, comments)
+	assert.NotContains(t, diff, "description")
+	assert.NotContains(t, diff, "comment 10001")
+	assert.Contains(t, diff, "--- comment 10002 (current)")
+	assert.Contains(t, diff, "-original\n+edited\n")
+
+	diff = editDiff("body", "new body", false, nil, nil)
+	assert.Contains(t, diff, "-body\n+new body\n")
+
+	diff = editDiff("body", "", true, nil, nil)
+	assert.Contains(t, diff, "--- description (current)")
+	assert.Contains(t, diff, "-body\n")
+}
+
+func TestConversionDiff(t *testing.T) {
+	translator, reverse := md2adf.NewTranslator(), editing.NewAdf2MdTranslator(nil)
+
+	for _, body := range []string{
+		"Hello **world**\n\n- first\n- second",
+		"~~struck~~ through",
+		"| a | b |\n|---|---|\n| 1 | 2 |",
+		"# Title\n\n1. first\n2. second",
+	} {
+		diff, err := conversionDiff("description", body, translator, reverse)
+		assert.NoError(t, err)
+		assert.Empty(t, diff, body)
+	}
+
+	diff, err := conversionDiff("description", "Kept\n\n<div>dropped</div>", translator, reverse)
+	assert.NoError(t, err)
+	assert.Contains(t, diff, "--- description (edited)\n+++ description (as sent)\n")
+	assert.Contains(t, diff, "-<div>dropped</div>\n")
+
+	diff, err = conversionDiff("description", "Above\n\n---\n\nBelow", translator, reverse)
+	assert.NoError(t, err)
+	assert.Contains(t, diff, "----\n")
+
+	diff, err = lossDiff("", []editComment{{id: "10001", body: "- [ ] task"}}, translator, reverse)
+	assert.NoError(t, err)
+	assert.Contains(t, diff, "--- comment 10001 (edited)")
+}
+
+func TestSameText(t *testing.T) {
+	table := "| a | b |\n|---|---|\n| 1 | 2 |"
+	assert.True(t, sameText(table, "| **a** | **b** |\n|-------|-------|\n| 1     | 2     |"))
+	assert.False(t, sameText(table, "a b 1 2"))
+
+	assert.True(t, sameText("* first\n* second", "- first\n- second"))
+	assert.False(t, sameText("- first\n- second", "first\nsecond"))
+	assert.False(t, sameText("Above\n\n---\n\nBelow", "Above\n\nBelow"))
+	assert.True(t, sameText("~~struck~~ *text*", "-~struck~- _text_"))
+}
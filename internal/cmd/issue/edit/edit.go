@@ -1,7 +1,9 @@
 package edit
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"regexp"
 	"strings"
@@ -44,7 +46,19 @@ $ jira issue edit ISSUE-1 -s"New updated summary" --no-input
 $ echo "Description from stdin" | jira issue edit ISSUE-1 -s"New updated summary"  --no-input
 
 # Use minus (-) to remove label, component or fixVersion
-$ jira issue edit ISSUE-1 --label -urgent --component -BE --fix-version -v1.0`
+$ jira issue edit ISSUE-1 --label -urgent --component -BE --fix-version -v1.0
+
+# Change the type of the issue
+$ jira issue edit ISSUE-1 --type Bug --no-input
+
+# Detach the issue from its parent or epic
+$ jira issue edit ISSUE-1 --parent none --no-input
+
+# Review the changes made in the editor before they are submitted
+$ jira issue edit ISSUE-1 --show-diff
+
+# Set date, datetime and user custom fields, users can be given by email
+$ jira issue edit ISSUE-1 --custom due-date=2024-03-01 --custom started=2024-03-01T10:00:00+02:00 --custom reviewer=jane@example.com`
 )
 
 // NewCmdEdit is an edit command.
@@ -104,6 +118,11 @@ func edit(cmd *cobra.Command, args []string) {
 	}()
 	cmdutil.ExitIfError(err)
 
+	if params.issueType != "" {
+		params.issueType, err = verifyIssueType(client, issue, params.issueType)
+		cmdutil.ExitIfError(err)
+	}
+
 	var originalBody string
 
 	var adf2mdTranslator *adf2md.Translator
@@ -112,9 +131,7 @@ func edit(cmd *cobra.Command, args []string) {
 		return editing.ResolveUserIDToEmail(userID, client, project)
 	}
 
-	adf2mdTranslator = adf2md.NewTranslator(adf2md.NewJiraMarkdownTranslator(
-		adf2md.WithUserEmailResolver(emailResolver),
-	))
+	adf2mdTranslator = editing.NewAdf2MdTranslator(emailResolver)
 
 	if issue.Fields.Description != nil {
 		if adfBody, ok := issue.Fields.Description.(*adf.ADFNode); ok {
@@ -125,6 +142,10 @@ func edit(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	// Kept apart from the comments to show what changed with --show-diff
+	originalDescription := originalBody
+	originalComments := make([]string, 0, len(issue.Fields.Comment.Comments))
+
 	// Prepare content with comments separated by DO NOT EDIT lines
 	contentWithComments := originalBody
 
@@ -133,11 +154,7 @@ func edit(cmd *cobra.Command, args []string) {
 		for _, comment := range issue.Fields.Comment.Comments {
 
 			at := bubble.FormatDateTime(comment.Created, jira.RFC3339, "Local")
-			contentWithComments += fmt.Sprintf(
-				"\n\n# DO NOT EDIT THIS LINE - Comment by %s (at %s)\n\n",
-				comment.Author.GetDisplayableName(),
-				at,
-			)
+			contentWithComments += "\n\n" + editing.CommentSeparator(comment.Author.GetDisplayableName(), at) + "\n\n"
 
 			// Convert comment body from ADF to markdown if needed
 			var commentBody string
@@ -148,6 +165,7 @@ func edit(cmd *cobra.Command, args []string) {
 				commentBody = comment.Body.(string)
 			}
 
+			originalComments = append(originalComments, commentBody)
 			contentWithComments += commentBody
 		}
 	}
@@ -155,11 +173,6 @@ func edit(cmd *cobra.Command, args []string) {
 	// Update originalBody to include comments for the editor
 	originalBody = contentWithComments
 
-	// HACK. TODO think of a better solution
-	// otherwise if we insert inline node into panel, '{/panel}' would
-	// literally become part of a paragraph
-	strings.ReplaceAll(originalBody, "{/panel}", "\n{/panel}")
-
 	cmdutil.ExitIfError(ec.askQuestions(issue, originalBody))
 
 	if !params.noInput {
@@ -172,12 +185,14 @@ func edit(cmd *cobra.Command, args []string) {
 	}
 
 	// Parse the edited content back into body and comments
+	var clearBody bool
 	if params.body != "" {
 		separatorPattern := regexp.MustCompile(`(?m)^# DO NOT EDIT THIS LINE - Comment by .* \(.*\)$`)
 		segments := separatorPattern.Split(params.body, -1)
 
 		// First segment is the body
 		params.body = strings.TrimSpace(segments[0])
+		clearBody = params.body == "" && originalDescription != ""
 
 		// Remaining segments are comments
 		expectedComments := len(issue.Fields.Comment.Comments)
@@ -217,8 +232,23 @@ func edit(cmd *cobra.Command, args []string) {
 		params.body = ""
 	}
 
+	if params.showDiff {
+		diff := editDiff(originalDescription, params.body, clearBody, originalComments, params.comments)
+		if diff == "" && params.onlyContentEdited(issue) {
+			fmt.Println("No changes to the description or comments, nothing to update")
+			return
+		}
+		if !showDiff(diff, params.noInput) {
+			cmdutil.Failed("Action aborted")
+		}
+	}
+
 	// TODO remove from editComments all the comments that are not edited (to prevent extra queries)
 
+	if params.noInput {
+		cmdutil.ExitIfError(checkRequiredFields(client, params, issue))
+	}
+
 	labels := params.labels
 	labels = append(labels, issue.Fields.Labels...)
 
@@ -240,20 +270,38 @@ func edit(cmd *cobra.Command, args []string) {
 	}
 	affectsVersions = append(affectsVersions, params.affectsVersions...)
 
+	useV3API := true
+	switch params.apiVersion {
+	case "2":
+		useV3API = false
+	case "3":
+		useV3API = true
+	default:
+		useV3API = viper.GetString("installation") != jira.InstallationTypeLocal
+	}
+
+	if useV3API {
+		// The translator may silently drop elements it doesn't support, check before sending
+		diff, err := lossDiff(params.body, params.comments, md2adfTranslator, adf2mdTranslator)
+		if err != nil {
+			cmdutil.ExitIfError(fmt.Errorf("Failed to convert markdown to adf, this may be a translator bug; %w", err))
+		}
+		if !confirmLoss(diff, params.noInput) {
+			cmdutil.Failed("Action aborted")
+		}
+	}
+
 	err = func() error {
 		s := cmdutil.Info("Updating an issue...")
 		defer s.Stop()
 
-		useV3API := true
-		if viper.GetString("installation") == jira.InstallationTypeLocal {
+		if !useV3API {
+			// V2 api only understands Jira wiki markup, refuse content it would corrupt
 			err := checkV2Safe(params.body, params.comments, md2adfTranslator)
 
 			if err != nil {
 				cmdutil.ExitIfError(jira.V3ContentToV2EndpointError(err))
 			}
-
-			// if there are no unsafe elements, we have to resort to V2 api...
-			useV3API = false
 		}
 
 		// Now process body and comments based on the chosen API version
@@ -261,7 +309,10 @@ func edit(cmd *cobra.Command, args []string) {
 
 		editComments := processCommentsForAPI(params.comments, useV3API, md2adfTranslator)
 
-		parent := cmdutil.GetJiraIssueKey(project, params.parentIssueKey)
+		parent := params.parentIssueKey
+		if parent != jira.AssigneeNone {
+			parent = cmdutil.GetJiraIssueKey(project, parent)
+		}
 		if parent == "" && issue.Fields.Parent != nil {
 			parent = issue.Fields.Parent.Key
 		}
@@ -269,9 +320,11 @@ func edit(cmd *cobra.Command, args []string) {
 		// Create EditRequest with comments
 		edr := jira.EditRequest{
 			ParentIssueKey:  parent,
+			IssueType:       params.issueType,
 			Summary:         params.summary,
 			Body:            body,
 			BodyIsRawADF:    bodyIsRawADF,
+			ClearBody:       clearBody,
 			Comments:        editComments,
 			Priority:        params.priority,
 			Labels:          labels,
@@ -283,6 +336,13 @@ func edit(cmd *cobra.Command, args []string) {
 		if configuredCustomFields, err := cmdcommon.GetConfiguredCustomFields(); err == nil {
 			cmdcommon.ValidateCustomFields(edr.CustomFields, configuredCustomFields)
 			edr.WithCustomFields(configuredCustomFields)
+			edr.ForInstallationType(viper.GetString("installation"))
+
+			users, err := resolveCustomFieldUsers(client, project, edr.CustomFields, configuredCustomFields)
+			if err != nil {
+				return err
+			}
+			edr.WithUsers(users)
 		}
 
 		// Choose API version based on content safety
@@ -293,11 +353,15 @@ func edit(cmd *cobra.Command, args []string) {
 		}
 	}()
 
+	var commentsErr *jira.ErrCommentsUpdateFailed
+	if errors.As(err, &commentsErr) {
+		cmdutil.Failed("%s", commentsErr)
+	}
 	cmdutil.ExitIfError(err)
 
 	cmdutil.Success("Issue updated\n%s", cmdutil.GenerateServerBrowseURL(server, params.issueKey))
 
-	handleUserAssign(project, params.issueKey, params.assignee, client)
+	handleUserAssign(project, params.issueKey, params.assignee, params.noInput, client)
 
 	if web, _ := cmd.Flags().GetBool("web"); web {
 		err := cmdutil.Navigate(server, params.issueKey)
@@ -444,7 +508,52 @@ func getAnswers(client *jira.Client, params *editParams, issue *jira.Issue) {
 	}
 }
 
-func handleUserAssign(project, key, assignee string, client *jira.Client) {
+// resolveCustomFieldUsers looks up the users given by email in user custom fields,
+// other values are passed to Jira as is.
+func resolveCustomFieldUsers(client *jira.Client, project string, fields map[string]string, configuredFields []jira.IssueTypeField) (map[string]*jira.User, error) {
+	users := make(map[string]*jira.User)
+	for key, val := range fields {
+		if !strings.Contains(val, "@") {
+			continue
+		}
+		for _, configured := range configuredFields {
+			identifier := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(configured.Name)), " ", "-")
+			if configured.Schema.DataType != "user" || (identifier != strings.ToLower(key) && key != configured.Key) {
+				continue
+			}
+
+			found, err := api.ProxyUserSearch(client, &jira.UserSearchOptions{
+				Query:   val,
+				Project: project,
+			})
+			if err != nil {
+				return nil, err
+			}
+			user := matchUserByEmail(found, val)
+			if user == nil {
+				return nil, fmt.Errorf("unable to find a user with email %q for %q", val, configured.Name)
+			}
+			users[val] = user
+		}
+	}
+	return users, nil
+}
+
+// matchUserByEmail picks the user with the email, Jira may hide emails so a
+// single search result without one is accepted too.
+func matchUserByEmail(users []*jira.User, email string) *jira.User {
+	for _, u := range users {
+		if strings.EqualFold(u.Email, email) {
+			return u
+		}
+	}
+	if len(users) == 1 && users[0].Email == "" {
+		return users[0]
+	}
+	return nil
+}
+
+func handleUserAssign(project, key, assignee string, noInput bool, client *jira.Client) {
 	if assignee == "" {
 		return
 	}
@@ -454,18 +563,88 @@ func handleUserAssign(project, key, assignee string, client *jira.Client) {
 		}
 		return
 	}
-	user, err := api.ProxyUserSearch(client, &jira.UserSearchOptions{
+	users, err := api.ProxyUserSearch(client, &jira.UserSearchOptions{
 		Query:   assignee,
 		Project: project,
 	})
-	if err != nil || len(user) == 0 {
+	if err != nil || len(users) == 0 {
 		cmdutil.Failed("Unable to find assignee")
 	}
-	if err = api.ProxyAssignIssue(client, key, user[0], assignee); err != nil {
+	user := matchAssignee(users, assignee)
+	if user == nil {
+		if noInput {
+			cmdutil.Failed("%s", ambiguousAssigneeError(users, assignee))
+		}
+		user, err = selectAssignee(users, assignee)
+		cmdutil.ExitIfError(err)
+	}
+	if err = api.ProxyAssignIssue(client, key, user, assignee); err != nil {
 		cmdutil.Failed("Unable to set assignee: %s", err.Error())
 	}
 }
 
+// matchAssignee picks the user unambiguously matching the query: the one with
+// the email, the only one with the exact name or the only search result.
+// It returns nil if the query matches several users.
+func matchAssignee(users []*jira.User, query string) *jira.User {
+	if len(users) == 1 {
+		return users[0]
+	}
+	for _, u := range users {
+		if strings.EqualFold(u.Email, query) {
+			return u
+		}
+	}
+
+	var exact []*jira.User
+	for _, u := range users {
+		if strings.EqualFold(u.DisplayName, query) || strings.EqualFold(u.Name, query) {
+			exact = append(exact, u)
+		}
+	}
+	if len(exact) == 1 {
+		return exact[0]
+	}
+	return nil
+}
+
+func describeUser(u *jira.User) string {
+	switch {
+	case u.Email != "":
+		return fmt.Sprintf("%s (%s)", u.DisplayName, u.Email)
+	case u.Name != "":
+		return fmt.Sprintf("%s (%s)", u.DisplayName, u.Name)
+	}
+	return u.DisplayName
+}
+
+func ambiguousAssigneeError(users []*jira.User, query string) error {
+	var out strings.Builder
+	out.WriteString(fmt.Sprintf("%d users match %q, use the email or the full name to pick one:", len(users), query))
+	for _, u := range users {
+		out.WriteString("\n  - " + describeUser(u))
+	}
+	return errors.New(out.String())
+}
+
+// selectAssignee asks which of the matching users to assign the issue to.
+func selectAssignee(users []*jira.User, query string) (*jira.User, error) {
+	options := make([]string, 0, len(users))
+	for _, u := range users {
+		options = append(options, describeUser(u))
+	}
+
+	var idx int
+	prompt := &survey.Select{
+		Message: fmt.Sprintf("%d users match %q, assign to:", len(users), query),
+		Options: options,
+	}
+	if err := survey.AskOne(prompt, &idx, defaultSurveyOptions()...); err != nil {
+		return nil, err
+	}
+	return users[idx], nil
+}
+
 type editCmd struct {
 	client *jira.Client
 	params *editParams
@@ -532,6 +711,7 @@ type editParams struct {
 	body           string
 	comments       []editComment
 	assignee       string
+	issueType      string
 
 	priority        string
 	labels          []string
@@ -541,7 +721,20 @@ type editParams struct {
 
 	customFields map[string]string
 	noInput      bool
+	showDiff     bool
 	debug        bool
+
+	// apiVersion forces v2 or v3 endpoints, empty means auto-detect
+	apiVersion string
+}
+
+// onlyContentEdited reports whether the description and the comments are all
+// the edit would change.
+func (p *editParams) onlyContentEdited(issue *jira.Issue) bool {
+	return (p.summary == "" || p.summary == issue.Fields.Summary) &&
+		p.parentIssueKey == "" && p.issueType == "" && p.assignee == "" && p.priority == "" &&
+		len(p.labels) == 0 && len(p.components) == 0 && len(p.fixVersions) == 0 &&
+		len(p.affectsVersions) == 0 && len(p.customFields) == 0
 }
 
 func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *editParams {
@@ -557,6 +750,9 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *e
 	priority, err := flags.GetString("priority")
 	cmdutil.ExitIfError(err)
 
+	issueType, err := flags.GetString("type")
+	cmdutil.ExitIfError(err)
+
 	assignee, err := flags.GetString("assignee")
 	cmdutil.ExitIfError(err)
 
@@ -578,15 +774,29 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *e
 	noInput, err := flags.GetBool("no-input")
 	cmdutil.ExitIfError(err)
 
+	showDiff, err := flags.GetBool("show-diff")
+	cmdutil.ExitIfError(err)
+
 	debug, err := flags.GetBool("debug")
 	cmdutil.ExitIfError(err)
 
+	apiVersion, err := flags.GetString("api-version")
+	cmdutil.ExitIfError(err)
+	switch apiVersion {
+	case "", "auto":
+		apiVersion = ""
+	case "2", "3":
+	default:
+		cmdutil.Failed("Invalid --api-version %q, expected one of: 2, 3, auto", apiVersion)
+	}
+
 	return &editParams{
 		issueKey:        cmdutil.GetJiraIssueKey(project, args[0]),
 		parentIssueKey:  parentIssueKey,
 		summary:         summary,
 		body:            body,
 		priority:        priority,
+		issueType:       issueType,
 		assignee:        assignee,
 		labels:          labels,
 		components:      components,
@@ -594,10 +804,50 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *e
 		affectsVersions: affectsVersions,
 		customFields:    custom,
 		noInput:         noInput,
+		showDiff:        showDiff,
 		debug:           debug,
+		apiVersion:      apiVersion,
 	}
 }
 
+// verifyIssueType checks that the issue can be changed to the type before anything
+// is edited, Jira only answers with a bare 400 otherwise. It returns the type as
+// named in Jira, or an empty string if the issue already has the type.
+func verifyIssueType(client *jira.Client, iss *jira.Issue, name string) (string, error) {
+	project, _, _ := strings.Cut(iss.Key, "-")
+
+	s := cmdutil.Info("Fetching issue types. Please wait...")
+	types, err := client.GetCreateMetaIssueTypes(project)
+	s.Stop()
+	if err != nil {
+		return "", err
+	}
+	return matchIssueType(types, iss, name)
+}
+
+func matchIssueType(types []*jira.IssueType, iss *jira.Issue, name string) (string, error) {
+	if strings.EqualFold(iss.Fields.IssueType.Name, name) {
+		return "", nil
+	}
+
+	switchable := jira.SwitchableIssueTypes(types, iss.Fields.IssueType)
+	all := make([]string, 0, len(switchable))
+	for _, it := range switchable {
+		if strings.EqualFold(it.Name, name) {
+			return it.Name, nil
+		}
+		all = append(all, fmt.Sprintf("'%s'", it.Name))
+	}
+
+	if len(all) == 0 {
+		return "", fmt.Errorf("no other issue type is available for issue %s", iss.Key)
+	}
+	return "", fmt.Errorf(
+		"invalid issue type %q\nAvailable types for issue %s: %s",
+		name, iss.Key, strings.Join(all, ", "),
+	)
+}
+
 func getEditMetadataQuestions(meta []string, customFields []*jira.Field, issue *jira.Issue, editMetadata *jira.EditMetadata, client *jira.Client, issueKey string) []*survey.Question {
 	var qs []*survey.Question
 
@@ -616,18 +866,20 @@ func getEditMetadataQuestions(meta []string, customFields []*jira.Field, issue *
 		customFieldMap[field.Name] = field
 	}
 
-	// Get autocomplete URLs for custom fields
-	var customFieldIds []string
+	// Get autocomplete URLs for custom fields and labels
+	var autocompleteFieldIds []string
 	for _, name := range meta {
 		if customField, ok := customFieldMap[name]; ok {
-			customFieldIds = append(customFieldIds, customField.ID)
+			autocompleteFieldIds = append(autocompleteFieldIds, customField.ID)
+		} else if name == "Labels" {
+			autocompleteFieldIds = append(autocompleteFieldIds, "labels")
 		}
 	}
 
-	// Fetch autocomplete URLs if we have custom fields
+	// Fetch autocomplete URLs if we have fields to complete
 	var autocompleteUrls map[string]string
-	if len(customFieldIds) > 0 {
-		autocompleteMetadata, err := client.GetEditMetadataWithFields(issueKey, customFieldIds)
+	if len(autocompleteFieldIds) > 0 {
+		autocompleteMetadata, err := client.GetEditMetadataWithFields(issueKey, autocompleteFieldIds)
 		if err == nil && autocompleteMetadata != nil {
 			autocompleteUrls = make(map[string]string)
 			for fieldId, fieldMeta := range autocompleteMetadata.Fields {
@@ -654,13 +906,24 @@ func getEditMetadataQuestions(meta []string, customFields []*jira.Field, issue *
 				},
 			})
 		case "Labels":
+			labelsPrompt := &survey.Input{
+				Message: "Labels",
+				Help:    "Comma separated list of labels. For eg: backend,urgent",
+				Default: strings.Join(issue.Fields.Labels, ","),
+			}
+
+			// Complete existing labels, so that a typo doesn't create a new one
+			if autocompleteUrl, hasAutocomplete := autocompleteUrls["labels"]; hasAutocomplete {
+				labelsPrompt.Suggest = func(toComplete string) []string {
+					return suggestLastItem(toComplete, func(query string) ([]string, error) {
+						return client.GetAutocompleteSuggestions(autocompleteUrl, url.QueryEscape(query))
+					})
+				}
+			}
+
 			qs = append(qs, &survey.Question{
-				Name: "labels",
-				Prompt: &survey.Input{
-					Message: "Labels",
-					Help:    "Comma separated list of labels. For eg: backend,urgent",
-					Default: strings.Join(issue.Fields.Labels, ","),
-				},
+				Name:   "labels",
+				Prompt: labelsPrompt,
 			})
 		case "FixVersions":
 			qs = append(qs, &survey.Question{
@@ -713,6 +976,26 @@ func getEditMetadataQuestions(meta []string, customFields []*jira.Field, issue *
 	return qs
 }
 
+// suggestLastItem completes the last item of a comma separated list, the
+// suggestions keep the items typed before it.
+func suggestLastItem(toComplete string, suggest func(string) ([]string, error)) []string {
+	i := strings.LastIndex(toComplete, ",") + 1
+	last := strings.TrimLeft(toComplete[i:], " ")
+	prefix := toComplete[:len(toComplete)-len(last)]
+
+	suggestions, err := suggest(strings.TrimSpace(last))
+	if err != nil {
+		// If autocomplete fails, return empty suggestions
+		return []string{}
+	}
+
+	out := make([]string, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		out = append(out, prefix+suggestion)
+	}
+	return out
+}
+
 // processBodyForAPI processes the body based on the chosen API version
 func processBodyForAPI(body string, useV3API bool, translator *md2adf.Translator) (string, bool) {
 	bodyIsRawADF := false
@@ -759,10 +1042,11 @@ func setFlags(cmd *cobra.Command) {
 
 	cmd.Flags().SortFlags = false
 
-	cmd.Flags().StringP("parent", "P", "", `Link to a parent key`)
+	cmd.Flags().StringP("parent", "P", "", `Link to a parent key, "none" to remove the parent`)
 	cmd.Flags().StringP("summary", "s", "", "Edit summary or title")
 	cmd.Flags().StringP("body", "b", "", "Edit description")
 	cmd.Flags().StringP("priority", "y", "", "Edit priority")
+	cmd.Flags().StringP("type", "t", "", "Change issue type")
 	cmd.Flags().StringP("assignee", "a", "", "Edit assignee (email or display name)")
 	cmd.Flags().StringArrayP("label", "l", []string{}, "Append labels")
 	cmd.Flags().StringArrayP("component", "C", []string{}, "Replace components")
@@ -771,4 +1055,7 @@ func setFlags(cmd *cobra.Command) {
 	cmd.Flags().StringToString("custom", custom, "Edit custom fields")
 	cmd.Flags().Bool("web", false, "Open in web browser after successful update")
 	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
+	cmd.Flags().Bool("show-diff", false, "Show the changes to the description and comments, and confirm them before submitting")
+	cmd.Flags().String("api-version", "auto", `Force Jira REST API version used for the update: 2, 3 or auto.
+By default v2 is used for local installations and v3 for cloud`)
 }
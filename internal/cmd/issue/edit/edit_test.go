@@ -0,0 +1,108 @@
+package edit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/md2adf-translator/adf"
+	"github.com/jorres/md2adf-translator/md2adf"
+
+	"github.com/jorres/jira-tui/internal/editing"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+func TestPanelFollowedByParagraphRoundTrip(t *testing.T) {
+	text := func(s string) *adf.ADFNode {
+		return &adf.ADFNode{Type: adf.ChildNodeText, Text: s}
+	}
+	paragraph := func(s string) *adf.ADFNode {
+		return &adf.ADFNode{Type: adf.NodeParagraph, Content: []*adf.ADFNode{text(s)}}
+	}
+
+	panel := adf.NewPanelNode("info")
+	panel.Content = []*adf.ADFNode{paragraph("Inside the panel")}
+	doc := &adf.ADFNode{Type: "doc", Content: []*adf.ADFNode{panel, paragraph("After the panel")}}
+
+	body := editing.NewAdf2MdTranslator(nil).Translate(doc)
+
+	out, err := editing.TranslateToADF(md2adf.NewTranslator(), body)
+	assert.NoError(t, err)
+
+	assert.Len(t, out.Content, 2)
+	assert.Equal(t, adf.NodePanel, out.Content[0].Type)
+	assert.Equal(t, []*adf.ADFNode{paragraph("Inside the panel")}, out.Content[0].Content)
+	assert.Equal(t, paragraph("After the panel"), out.Content[1])
+}
+
+func TestMatchUserByEmail(t *testing.T) {
+	jane := &jira.User{AccountID: "1", Email: "jane@example.com"}
+	john := &jira.User{AccountID: "2", Email: "john@example.com"}
+	hidden := &jira.User{AccountID: "3"}
+
+	assert.Equal(t, jane, matchUserByEmail([]*jira.User{john, jane}, "Jane@example.com"))
+	assert.Equal(t, hidden, matchUserByEmail([]*jira.User{hidden}, "jane@example.com"))
+	assert.Nil(t, matchUserByEmail([]*jira.User{john, hidden}, "jane@example.com"))
+	assert.Nil(t, matchUserByEmail([]*jira.User{john}, "jane@example.com"))
+	assert.Nil(t, matchUserByEmail(nil, "jane@example.com"))
+}
+
+func TestMatchAssignee(t *testing.T) {
+	johnDoe := &jira.User{AccountID: "1", DisplayName: "John Doe", Email: "john.doe@example.com"}
+	johnSmith := &jira.User{AccountID: "2", DisplayName: "John Smith", Email: "john.smith@example.com"}
+	john := &jira.User{AccountID: "3", DisplayName: "John", Name: "john"}
+
+	assert.Equal(t, johnDoe, matchAssignee([]*jira.User{johnDoe}, "john"))
+	assert.Equal(t, johnSmith, matchAssignee([]*jira.User{johnDoe, johnSmith}, "John.Smith@example.com"))
+	assert.Equal(t, john, matchAssignee([]*jira.User{johnDoe, johnSmith, john}, "john"))
+	assert.Nil(t, matchAssignee([]*jira.User{johnDoe, johnSmith}, "john"))
+
+	err := ambiguousAssigneeError([]*jira.User{johnDoe, john}, "jo")
+	assert.EqualError(t, err, `2 users match "jo", use the email or the full name to pick one:
+  - John Doe (john.doe@example.com)
+  - John (john)`)
+}
+
+func TestSuggestLastItem(t *testing.T) {
+	var queries []string
+	suggest := func(query string) ([]string, error) {
+		queries = append(queries, query)
+		return []string{"urgent", "urgent-fix"}, nil
+	}
+
+	assert.Equal(t, []string{"urgent", "urgent-fix"}, suggestLastItem("urg", suggest))
+	assert.Equal(t, []string{"backend, urgent", "backend, urgent-fix"}, suggestLastItem("backend, urg", suggest))
+	assert.Equal(t, []string{"urg", "urg"}, queries)
+
+	failing := func(string) ([]string, error) { return nil, assert.AnError }
+	assert.Empty(t, suggestLastItem("backend,urg", failing))
+}
+
+func TestMatchIssueType(t *testing.T) {
+	types := []*jira.IssueType{{Name: "Story"}, {Name: "Bug"}, {Name: "Sub-task", Subtask: true}}
+	story := &jira.Issue{Key: "TEST-1", Fields: jira.IssueFields{IssueType: jira.IssueType{Name: "Story"}}}
+	subtask := &jira.Issue{Key: "TEST-2", Fields: jira.IssueFields{IssueType: jira.IssueType{Name: "Sub-task", Subtask: true}}}
+
+	name, err := matchIssueType(types, story, "bug")
+	assert.NoError(t, err)
+	assert.Equal(t, "Bug", name)
+
+	name, err = matchIssueType(types, story, "story")
+	assert.NoError(t, err)
+	assert.Empty(t, name)
+
+	_, err = matchIssueType(types, story, "Sub-task")
+	assert.EqualError(t, err, "invalid issue type \"Sub-task\"\nAvailable types for issue TEST-1: 'Bug'")
+
+	_, err = matchIssueType(types, subtask, "Bug")
+	assert.EqualError(t, err, "no other issue type is available for issue TEST-2")
+}
+
+func TestOnlyContentEdited(t *testing.T) {
+	issue := &jira.Issue{}
+	issue.Fields.Summary = "Login fails"
+
+	assert.True(t, (&editParams{summary: "Login fails", body: "new"}).onlyContentEdited(issue))
+	assert.False(t, (&editParams{summary: "Login fails on mobile"}).onlyContentEdited(issue))
+	assert.False(t, (&editParams{labels: []string{"urgent"}}).onlyContentEdited(issue))
+}
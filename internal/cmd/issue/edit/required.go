@@ -0,0 +1,81 @@
+package edit
+
+import (
+	"fmt"
+
+	"github.com/jorres/jira-tui/internal/cmdcommon"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// checkRequiredFields fails with the names of the required fields the edit
+// would leave empty, instead of a bare 400 from Jira.
+func checkRequiredFields(client *jira.Client, params *editParams, issue *jira.Issue) error {
+	editMetadata, err := client.GetEditMetadata(params.issueKey)
+	if err != nil {
+		return fmt.Errorf("Failed to get issue edit metadata: %w", err)
+	}
+	if missing := missingEditFields(editMetadata, params, issue); len(missing) > 0 {
+		return cmdcommon.MissingRequiredFieldsError(missing)
+	}
+	return nil
+}
+
+// missingEditFields returns the names of the required fields the edit clears.
+// Fields the edit doesn't touch are kept as they are, so only removals count.
+func missingEditFields(meta *jira.EditMetadata, params *editParams, issue *jira.Issue) []string {
+	required := make(map[string]string)
+	for key, f := range meta.Fields {
+		if f.Required {
+			required[key] = f.Name
+		}
+	}
+
+	components := make([]string, 0, len(issue.Fields.Components))
+	for _, c := range issue.Fields.Components {
+		components = append(components, c.Name)
+	}
+	fixVersions := make([]string, 0, len(issue.Fields.FixVersions))
+	for _, v := range issue.Fields.FixVersions {
+		fixVersions = append(fixVersions, v.Name)
+	}
+	affectsVersions := make([]string, 0, len(issue.Fields.AffectsVersions))
+	for _, v := range issue.Fields.AffectsVersions {
+		affectsVersions = append(affectsVersions, v.Name)
+	}
+
+	cleared := map[string]bool{
+		"parent":      params.parentIssueKey == jira.AssigneeNone,
+		"assignee":    params.assignee == "x",
+		"labels":      clearsAll(issue.Fields.Labels, params.labels),
+		"components":  clearsAll(components, params.components),
+		"fixVersions": clearsAll(fixVersions, params.fixVersions),
+		"versions":    clearsAll(affectsVersions, params.affectsVersions),
+	}
+
+	return cmdcommon.MissingRequiredFields(required, func(key string) bool { return !cleared[key] })
+}
+
+// clearsAll tells if the edit removes every current value, values prefixed
+// with a minus are removed and the others added.
+func clearsAll(current, edit []string) bool {
+	if len(edit) == 0 {
+		return false
+	}
+	removed := make(map[string]bool)
+	for _, v := range edit {
+		if len(v) > 1 && v[0] == '-' {
+			removed[v[1:]] = true
+		}
+	}
+	for _, v := range edit {
+		if v != "" && v[0] != '-' && !removed[v] {
+			return false
+		}
+	}
+	for _, v := range current {
+		if !removed[v] {
+			return false
+		}
+	}
+	return true
+}
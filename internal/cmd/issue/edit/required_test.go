@@ -0,0 +1,29 @@
+package edit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+func TestClearsAll(t *testing.T) {
+	assert.False(t, clearsAll([]string{"a"}, nil))
+	assert.False(t, clearsAll([]string{"a", "b"}, []string{"-a"}))
+	assert.False(t, clearsAll([]string{"a"}, []string{"-a", "b"}))
+	assert.True(t, clearsAll([]string{"a", "b"}, []string{"-a", "-b"}))
+}
+
+func TestMissingEditFields(t *testing.T) {
+	meta := &jira.EditMetadata{Fields: map[string]jira.FieldMetadata{
+		"labels":   {Name: "Labels", Required: true},
+		"parent":   {Name: "Parent", Required: true},
+		"assignee": {Name: "Assignee"},
+	}}
+	issue := &jira.Issue{Fields: jira.IssueFields{Labels: []string{"backend"}}}
+
+	assert.Empty(t, missingEditFields(meta, &editParams{summary: "New title"}, issue))
+	assert.Equal(t, []string{"Labels", "Parent"},
+		missingEditFields(meta, &editParams{labels: []string{"-backend"}, parentIssueKey: jira.AssigneeNone, assignee: "x"}, issue))
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/jorres/jira-tui/internal/cmd/issue/assign"
+	"github.com/jorres/jira-tui/internal/cmd/issue/backlog"
+	"github.com/jorres/jira-tui/internal/cmd/issue/board"
 	"github.com/jorres/jira-tui/internal/cmd/issue/clone"
 	"github.com/jorres/jira-tui/internal/cmd/issue/comment"
 	"github.com/jorres/jira-tui/internal/cmd/issue/create"
@@ -12,9 +14,11 @@ import (
 	"github.com/jorres/jira-tui/internal/cmd/issue/link"
 	"github.com/jorres/jira-tui/internal/cmd/issue/list"
 	"github.com/jorres/jira-tui/internal/cmd/issue/move"
+	"github.com/jorres/jira-tui/internal/cmd/issue/transitionbulk"
 	"github.com/jorres/jira-tui/internal/cmd/issue/unlink"
 	"github.com/jorres/jira-tui/internal/cmd/issue/view"
 	"github.com/jorres/jira-tui/internal/cmd/issue/watch"
+	"github.com/jorres/jira-tui/internal/cmd/issue/watchquery"
 	"github.com/jorres/jira-tui/internal/cmd/issue/worklog"
 )
 
@@ -38,6 +42,8 @@ func NewCmdIssue() *cobra.Command {
 		lc, cc, edit.NewCmdEdit(), move.NewCmdMove(), view.NewCmdView(), assign.NewCmdAssign(),
 		link.NewCmdLink(), unlink.NewCmdUnlink(), comment.NewCmdComment(), clone.NewCmdClone(),
 		delete.NewCmdDelete(), watch.NewCmdWatch(), worklog.NewCmdWorklog(),
+		backlog.NewCmdBacklog(), board.NewCmdBoard(), transitionbulk.NewCmdTransitionBulk(),
+		watchquery.NewCmdWatchQuery(),
 	)
 
 	list.SetFlags(lc)
@@ -23,6 +23,9 @@ You can combine different flags to create a unique query. For instance,
 # Issues that are of high priority, is in progress, was created this month, and has given labels
 $ jira issue list -yHigh -s"In Progress" --created month -lbackend -l"high prio"
 
+# Issues that changed during the last week
+$ jira issue list --updated-since 7d
+
 You can also add an optional search query as a positional argument, which functions the same
 as entering a search query into the Jira UI's search box.
 
@@ -38,9 +41,22 @@ $ jira issue list --paginate 20
 # Get 50 items starting from 10
 $ jira issue list --paginate 10:50
 
+# List the first 250 issues
+$ jira issue list --limit 250
+
+# List every issue matching the query
+$ jira issue list -s"In Progress" --all --plain
+
 # Search for issues containing specific text
 $ jira issue list "Feature Request"
 
+# List open issues, or the ones resolved as a duplicate
+$ jira issue list --open
+$ jira issue list --resolution Duplicate
+
+# List epics with their completion
+$ jira issue list -tEpic --progress
+
 # List issues in a plain table view without headers
 $ jira issue list --plain --no-headers
 
@@ -59,6 +75,9 @@ $ jira issue list -tEpic -sDone
 # List issues in status other than "Open" and is assigned to no one
 $ jira issue list -s~Open -ax
 
+# Count open issues assigned to you
+$ jira issue list -s"Open" -a$(jira me) --count
+
 # List issues from all projects
 $ jira issue list -q"project IS NOT EMPTY"`
 )
@@ -105,6 +124,32 @@ func loadList(cmd *cobra.Command, args []string) {
 		cmdutil.ExitIfError(cmd.Flags().Set("jql", searchQuery))
 	}
 
+	cmdutil.ExitIfError(checkResolution(cmd, debug))
+
+	count, err := cmd.Flags().GetBool("count")
+	cmdutil.ExitIfError(err)
+
+	if count {
+		total, err := countIssues(project, cmd, debug)
+		cmdutil.ExitIfError(err)
+		fmt.Println(total)
+		return
+	}
+
+	raw, err := cmd.Flags().GetBool("raw")
+	cmdutil.ExitIfError(err)
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	showProgress, err := cmd.Flags().GetBool("progress")
+	cmdutil.ExitIfError(err)
+
+	var (
+		progress  map[string]api.EpicProgress
+		truncated bool
+	)
+
 	issues, total, err := func() ([]*jira.Issue, int, error) {
 		s := cmdutil.Info("Fetching issues...")
 		defer s.Stop()
@@ -114,10 +159,20 @@ func loadList(cmd *cobra.Command, args []string) {
 			return nil, 0, err
 		}
 
-		resp, err := api.ProxySearch(api.DefaultClient(debug), q.Get(), q.Params().From, q.Params().Limit)
+		client := api.DefaultClient(debug)
+
+		limit, all := searchLimit(cmd, q.Params().Limit)
+		resp, err := api.SearchAll(&api.LiveFetcher{Client: client}, q.Get(), q.Params().From, limit)
 		if err != nil {
 			return nil, 0, err
 		}
+		truncated = all && resp.Total > int(limit)
+
+		// Lists of epics get a progress column on request, scripts parsing the
+		// plain output don't.
+		if showProgress && !raw && !plain && strings.EqualFold(q.Params().IssueType, jira.IssueTypeEpic) {
+			progress = api.ProxyEpicsProgress(client, viper.GetString("project.type"), issueKeys(resp.Issues))
+		}
 
 		return resp.Issues, resp.Total, nil
 	}()
@@ -129,17 +184,15 @@ func loadList(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	raw, err := cmd.Flags().GetBool("raw")
-	cmdutil.ExitIfError(err)
+	if truncated {
+		cmdutil.Warn("The query matches %d issues, only the first %d are listed", total, len(issues))
+	}
 
 	if raw {
 		outputRawJSON(issues)
 		return
 	}
 
-	plain, err := cmd.Flags().GetBool("plain")
-	cmdutil.ExitIfError(err)
-
 	noHeaders, err := cmd.Flags().GetBool("no-headers")
 	cmdutil.ExitIfError(err)
 
@@ -160,6 +213,7 @@ func loadList(cmd *cobra.Command, args []string) {
 		Refresh: func() {
 			loadList(cmd, args)
 		},
+		Progress: progress,
 		Display: view.DisplayFormat{
 			Plain:        plain,
 			NoHeaders:    noHeaders,
@@ -179,6 +233,97 @@ func loadList(cmd *cobra.Command, args []string) {
 	cmdutil.ExitIfError(v.Render())
 }
 
+// searchLimit returns the number of issues to fetch, --limit and --all take
+// precedence over the limit of --paginate. With --all the limit is capped, the
+// returned flag tells if it is in effect.
+func searchLimit(cmd *cobra.Command, paginated uint) (uint, bool) {
+	if cmd.Flags().Lookup("all") == nil {
+		return paginated, false
+	}
+
+	all, err := cmd.Flags().GetBool("all")
+	cmdutil.ExitIfError(err)
+	if all {
+		return api.SearchAllCap, true
+	}
+
+	limit, err := cmd.Flags().GetUint("limit")
+	cmdutil.ExitIfError(err)
+	if limit > 0 {
+		return limit, false
+	}
+	return paginated, false
+}
+
+// checkResolution makes sure the resolution filter names a resolution of the
+// instance, a typo would otherwise fail with a bare JQL error.
+func checkResolution(cmd *cobra.Command, debug bool) error {
+	open, err := cmd.Flags().GetBool("open")
+	if err != nil {
+		return err
+	}
+	resolution, err := cmd.Flags().GetString("resolution")
+	if err != nil {
+		return err
+	}
+
+	if open && resolution != "" {
+		return fmt.Errorf("--open can't be combined with --resolution")
+	}
+	if resolution == "" || query.IsUnresolved(resolution) {
+		return nil
+	}
+
+	resolutions, err := func() ([]*jira.Resolution, error) {
+		s := cmdutil.Info("Checking resolution...")
+		defer s.Stop()
+
+		return api.DefaultClient(debug).GetResolutions()
+	}()
+	if err != nil {
+		return err
+	}
+	return matchResolution(resolutions, resolution)
+}
+
+// matchResolution checks the resolution, possibly negated with ~, against the
+// resolutions of the instance. Names are case-insensitive, as in JQL.
+func matchResolution(resolutions []*jira.Resolution, resolution string) error {
+	name := strings.TrimPrefix(resolution, "~")
+
+	valid := []string{"'Unresolved'"}
+	for _, r := range resolutions {
+		if strings.EqualFold(r.Name, name) {
+			return nil
+		}
+		valid = append(valid, fmt.Sprintf("'%s'", r.Name))
+	}
+	return fmt.Errorf("invalid resolution %q\nAvailable resolutions: %s", name, strings.Join(valid, ", "))
+}
+
+// countIssues runs the query without fetching any issues and returns the number of matches.
+func countIssues(project string, cmd *cobra.Command, debug bool) (int, error) {
+	q, err := query.NewIssue(project, cmd.Flags())
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := api.ProxySearch(api.DefaultClient(debug), q.Get(), 0, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Total, nil
+}
+
+func issueKeys(issues []*jira.Issue) []string {
+	keys := make([]string, 0, len(issues))
+	for _, iss := range issues {
+		keys = append(keys, iss.Key)
+	}
+	return keys
+}
+
 func outputRawJSON(issues []*jira.Issue) {
 	data, err := json.MarshalIndent(issues, "", "  ")
 	if err != nil {
@@ -193,7 +338,8 @@ func SetFlags(cmd *cobra.Command) {
 	cmd.Flags().SortFlags = false
 
 	cmd.Flags().StringP("type", "t", "", "Filter issues by type")
-	cmd.Flags().StringP("resolution", "R", "", "Filter issues by resolution type")
+	cmd.Flags().StringP("resolution", "R", "", "Filter issues by resolution type, Unresolved lists open issues")
+	cmd.Flags().Bool("open", false, "Only list unresolved issues, same as --resolution Unresolved")
 	cmd.Flags().StringArrayP("status", "s", []string{}, "Filter issues by status")
 	cmd.Flags().StringP("priority", "y", "", "Filter issues by priority")
 	cmd.Flags().StringP("reporter", "r", "", "Filter issues by reporter (email or display name)")
@@ -215,18 +361,33 @@ func SetFlags(cmd *cobra.Command) {
 	cmd.Flags().String("updated-after", "", "Filter by issues updated after certain date")
 	cmd.Flags().String("created-before", "", "Filter by issues created before certain date")
 	cmd.Flags().String("updated-before", "", "Filter by issues updated before certain date")
+	cmd.Flags().String("created-since", "", "Filter by issues created within a period or since a date\n"+
+		"Accepts a period using w = weeks, d = days, h = hours, m = minutes, eg: 7d,\n"+
+		"or a date in yyyy-mm-dd and yyyy/mm/dd format")
+	cmd.Flags().String("updated-since", "", "Filter by issues updated within a period or since a date\n"+
+		"Accepts a period using w = weeks, d = days, h = hours, m = minutes, eg: 7d,\n"+
+		"or a date in yyyy-mm-dd and yyyy/mm/dd format")
 	cmd.Flags().StringP("jql", "q", "", "Run a raw JQL query in a given project context")
-	cmd.Flags().String("order-by", "created", "Field to order the list with")
+	cmd.Flags().String("order-by", "created", "Field to order the list with, rank lists issues in board order")
 	cmd.Flags().Bool("reverse", false, "Reverse the display order (default \"DESC\")")
 	cmd.Flags().String("paginate", "0:100", "Paginate the result. Max 100 at a time, format: <from>:<limit> where <from> is optional")
 	cmd.Flags().Bool("plain", false, "Display output in plain mode")
 	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
 	cmd.Flags().Bool("no-truncate", false, "Show all available columns in plain mode. Works only with --plain")
 	cmd.Flags().Bool("raw", false, "Print raw JSON output")
+	cmd.Flags().Bool("count", false, "Print only the number of issues matching the query")
+
+	if cmd.HasParent() && cmd.Parent().Name() == "issue" {
+		cmd.Flags().Uint("limit", 0, "Number of issues to list, fetched in pages of 100. Overrides the limit of --paginate")
+		cmd.Flags().Bool("all", false, fmt.Sprintf("List every matching issue, up to %d", api.SearchAllCap))
+		cmd.MarkFlagsMutuallyExclusive("limit", "all")
+	}
 
 	if cmd.HasParent() && cmd.Parent().Name() != "sprint" {
 		cmd.Flags().String("columns", "", "Comma separated list of columns to display in the plain mode.\n"+
 			fmt.Sprintf("Accepts: %s", strings.Join(view.ValidIssueColumns(), ", ")))
 		cmd.Flags().Uint("fixed-columns", 1, "Number of fixed columns in the interactive mode")
+		cmd.Flags().Bool("progress", false, "Show the completion of listed epics in the interactive mode.\n"+
+			"Takes two extra searches per epic")
 	}
 }
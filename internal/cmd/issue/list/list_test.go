@@ -0,0 +1,18 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+func TestMatchResolution(t *testing.T) {
+	resolutions := []*jira.Resolution{{Name: "Fixed"}, {Name: "Won't Do"}}
+
+	assert.NoError(t, matchResolution(resolutions, "fixed"))
+	assert.NoError(t, matchResolution(resolutions, "~Won't Do"))
+	assert.EqualError(t, matchResolution(resolutions, "Fixd"),
+		"invalid resolution \"Fixd\"\nAvailable resolutions: 'Unresolved', 'Fixed', 'Won't Do'")
+}
@@ -0,0 +1,163 @@
+package transitionbulk
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/api"
+	"github.com/jorres/jira-tui/internal/cmdutil"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+const (
+	helpText = `Transition-bulk transitions every issue matching a JQL query to a given state.
+
+Issues that can't be moved to the state are reported and skipped, the rest are still transitioned.`
+	examples = `$ jira issue transition-bulk --jql "sprint = 42 AND status = 'In Review'" --to Done
+
+# See which issues would be transitioned
+$ jira issue transition-bulk --jql "sprint = 42 AND status = 'In Review'" --to Done --dry-run`
+)
+
+// NewCmdTransitionBulk is a bulk transition command.
+func NewCmdTransitionBulk() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "transition-bulk",
+		Short:   "Transition all issues matching a JQL to a given state",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"move-bulk"},
+		Args:    cobra.NoArgs,
+		Run:     transitionBulk,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("jql", "", "JQL selecting the issues to transition")
+	cmd.Flags().String("to", "", "State to transition the issues to")
+	cmd.Flags().Bool("dry-run", false, "Only list the issues that would be transitioned")
+
+	_ = cmd.MarkFlagRequired("jql")
+	_ = cmd.MarkFlagRequired("to")
+
+	return &cmd
+}
+
+func transitionBulk(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	jql, err := cmd.Flags().GetString("jql")
+	cmdutil.ExitIfError(err)
+
+	state, err := cmd.Flags().GetString("to")
+	cmdutil.ExitIfError(err)
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+
+	var total int
+	issues, err := func() ([]*jira.Issue, error) {
+		s := cmdutil.Info("Searching for issues...")
+		defer s.Stop()
+
+		res, err := api.SearchAll(&api.LiveFetcher{Client: client}, jql, 0, api.SearchAllCap)
+		if err != nil {
+			return nil, err
+		}
+		total = res.Total
+		return res.Issues, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(issues) == 0 {
+		cmdutil.Failed("No issues match the query")
+	}
+	if total > len(issues) {
+		cmdutil.Warn("The query matches %d issues, only the first %d are transitioned", total, len(issues))
+	}
+
+	if dryRun {
+		fmt.Printf("%d issue(s) would be transitioned to %q:\n", len(issues), state)
+		for _, iss := range issues {
+			fmt.Printf("  %s\t[%s]\t%s\n", iss.Key, iss.Fields.Status.Name, iss.Fields.Summary)
+		}
+		return
+	}
+
+	installation := viper.GetString("installation")
+
+	var transitioned, skipped, failed int
+	for _, iss := range issues {
+		if strings.EqualFold(iss.Fields.Status.Name, state) {
+			fmt.Printf("- %s is already in %q, skipping\n", iss.Key, iss.Fields.Status.Name)
+			skipped++
+			continue
+		}
+
+		err := func() error {
+			s := cmdutil.Info(fmt.Sprintf("Transitioning %s...", iss.Key))
+			defer s.Stop()
+
+			transitions, err := api.ProxyTransitions(client, iss.Key)
+			if err != nil {
+				return err
+			}
+			tr, err := findTransition(transitions, state, installation)
+			if err != nil {
+				return err
+			}
+
+			_, err = client.Transition(iss.Key, &jira.TransitionRequest{
+				Transition: &jira.TransitionRequestData{
+					ID:   tr.ID.String(),
+					Name: tr.Name,
+				},
+			})
+			return err
+		}()
+		if err != nil {
+			cmdutil.Fail("%s: %s", iss.Key, err)
+			failed++
+			continue
+		}
+
+		fmt.Printf("✓ %s transitioned to %q\n", iss.Key, state)
+		transitioned++
+	}
+
+	summary := fmt.Sprintf("%d transitioned, %d skipped, %d failed", transitioned, skipped, failed)
+	if failed > 0 {
+		cmdutil.Fail("%s", summary)
+		os.Exit(1)
+	}
+	cmdutil.Success("%s", summary)
+}
+
+// findTransition looks up the transition leading to the state, the match is
+// case-insensitive.
+func findTransition(transitions []*jira.Transition, state, installation string) (*jira.Transition, error) {
+	all := make([]string, 0, len(transitions))
+	for _, t := range transitions {
+		if !strings.EqualFold(t.Name, state) {
+			all = append(all, fmt.Sprintf("'%s'", t.Name))
+			continue
+		}
+		// Jira API v2 doesn't return "isAvailable", so it's only checked for the cloud installation.
+		if installation == jira.InstallationTypeCloud && !t.IsAvailable {
+			return nil, fmt.Errorf("transition to %q is not available", t.Name)
+		}
+		return t, nil
+	}
+
+	if len(all) == 0 {
+		return nil, fmt.Errorf("no transitions available")
+	}
+	return nil, fmt.Errorf("no transition to %q, available: %s", state, strings.Join(all, ", "))
+}
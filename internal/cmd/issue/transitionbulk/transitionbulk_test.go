@@ -0,0 +1,34 @@
+package transitionbulk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+func TestFindTransition(t *testing.T) {
+	transitions := []*jira.Transition{
+		{ID: "11", Name: "In Progress", IsAvailable: true},
+		{ID: "31", Name: "Done", IsAvailable: false},
+	}
+
+	tr, err := findTransition(transitions, "in progress", jira.InstallationTypeCloud)
+	assert.NoError(t, err)
+	assert.Equal(t, "11", tr.ID.String())
+
+	_, err = findTransition(transitions, "Done", jira.InstallationTypeCloud)
+	assert.EqualError(t, err, `transition to "Done" is not available`)
+
+	// isAvailable isn't returned by the v2 API
+	tr, err = findTransition(transitions, "done", jira.InstallationTypeLocal)
+	assert.NoError(t, err)
+	assert.Equal(t, "Done", tr.Name)
+
+	_, err = findTransition(transitions, "Closed", jira.InstallationTypeCloud)
+	assert.EqualError(t, err, `no transition to "Closed", available: 'In Progress', 'Done'`)
+
+	_, err = findTransition(nil, "Closed", jira.InstallationTypeCloud)
+	assert.EqualError(t, err, "no transitions available")
+}
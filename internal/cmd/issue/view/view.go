@@ -2,14 +2,23 @@ package view
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/atotto/clipboard"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/jorres/md2adf-translator/adf"
+	"github.com/jorres/md2adf-translator/adf2md"
+
 	"github.com/jorres/jira-tui/api"
+	"github.com/jorres/jira-tui/internal/bubble"
 	"github.com/jorres/jira-tui/internal/cmdutil"
+	"github.com/jorres/jira-tui/internal/editing"
+	"github.com/jorres/jira-tui/internal/history"
 	tuiView "github.com/jorres/jira-tui/internal/view"
 	"github.com/jorres/jira-tui/pkg/jira"
+	"github.com/jorres/jira-tui/pkg/jira/filter"
 	"github.com/jorres/jira-tui/pkg/jira/filter/issue"
 )
 
@@ -20,13 +29,27 @@ const (
 # Show 5 recent comments when viewing the issue
 $ jira issue view ISSUE-1 --comments 5
 
+# Show custom fields in the header
+$ jira issue view ISSUE-1 --fields customfield_10020,customfield_10042
+
 # Get the raw JSON data
-$ jira issue view ISSUE-1 --raw`
+$ jira issue view ISSUE-1 --raw
+
+# Print the description and the last 5 comments as markdown, e.g. to pipe into pandoc
+$ jira issue view ISSUE-1 --markdown --comments 5 | pandoc -f markdown -o ISSUE-1.pdf
+
+# Open the issue in the browser, the URL is printed and copied if no browser is available
+$ jira issue view ISSUE-1 --web`
 
 	flagRaw      = "raw"
 	flagDebug    = "debug"
 	flagComments = "comments"
 	flagPlain    = "plain"
+	flagFields   = "fields"
+	flagWeb      = "web"
+
+	flagMarkdown     = "markdown"
+	flagNoSeparators = "no-separators"
 
 	configProject = "project.key"
 	configServer  = "server"
@@ -52,11 +75,25 @@ func NewCmdView() *cobra.Command {
 	cmd.Flags().Uint(flagComments, 1, "Show N comments")
 	cmd.Flags().Bool(flagPlain, false, "Display output in plain mode")
 	cmd.Flags().Bool(flagRaw, false, "Print raw Jira API response")
+	cmd.Flags().StringSlice(flagFields, []string{}, "Comma separated list of extra fields to show, eg: customfield_10020")
+	cmd.Flags().Bool(flagWeb, false, "Open the issue in the browser instead of rendering it")
+	cmd.Flags().Bool(flagMarkdown, false, "Print the description and comments as markdown, as the editor shows them")
+	cmd.Flags().Bool(flagNoSeparators, false, "Leave out the lines separating the comments with --markdown")
+
+	cmd.MarkFlagsMutuallyExclusive(flagRaw, flagMarkdown, flagPlain)
 
 	return &cmd
 }
 
 func view(cmd *cobra.Command, args []string) {
+	web, err := cmd.Flags().GetBool(flagWeb)
+	cmdutil.ExitIfError(err)
+
+	if web {
+		viewWeb(args)
+		return
+	}
+
 	raw, err := cmd.Flags().GetBool(flagRaw)
 	cmdutil.ExitIfError(err)
 
@@ -64,9 +101,39 @@ func view(cmd *cobra.Command, args []string) {
 		viewRaw(cmd, args)
 		return
 	}
+
+	markdown, err := cmd.Flags().GetBool(flagMarkdown)
+	cmdutil.ExitIfError(err)
+
+	if markdown {
+		viewMarkdown(cmd, args)
+		return
+	}
 	viewPretty(cmd, args)
 }
 
+// viewWeb opens the issue in the browser without fetching it. Without a browser,
+// e.g. in an SSH session, the URL is printed and copied to the clipboard.
+func viewWeb(args []string) {
+	server := viper.GetString(configServer)
+	key := cmdutil.GetJiraIssueKey(viper.GetString(configProject), args[0])
+	url := cmdutil.GenerateServerBrowseURL(server, key)
+
+	_ = history.Add(key)
+
+	if cmdutil.BrowserAvailable() && cmdutil.Navigate(server, key) == nil {
+		fmt.Println(url)
+		return
+	}
+
+	if err := clipboard.WriteAll(url); err != nil {
+		cmdutil.Warn("No browser available, open the issue at:")
+	} else {
+		cmdutil.Warn("No browser available, the URL is copied to the clipboard:")
+	}
+	fmt.Println(url)
+}
+
 func viewRaw(cmd *cobra.Command, args []string) {
 	debug, err := cmd.Flags().GetBool(flagDebug)
 	cmdutil.ExitIfError(err)
@@ -85,6 +152,64 @@ func viewRaw(cmd *cobra.Command, args []string) {
 	fmt.Println(apiResp)
 }
 
+// viewMarkdown prints the issue translated to markdown the way the editor
+// shows it, without rendering, so that it can be piped into other tools.
+func viewMarkdown(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool(flagDebug)
+	cmdutil.ExitIfError(err)
+
+	comments, err := cmd.Flags().GetUint(flagComments)
+	cmdutil.ExitIfError(err)
+
+	noSeparators, err := cmd.Flags().GetBool(flagNoSeparators)
+	cmdutil.ExitIfError(err)
+
+	project := viper.GetString(configProject)
+	key := cmdutil.GetJiraIssueKey(project, args[0])
+	client := api.DefaultClient(debug)
+
+	iss, err := func() (*jira.Issue, error) {
+		s := cmdutil.Info(messageFetchingData)
+		defer s.Stop()
+
+		return api.ProxyGetIssue(client, key, issue.NewNumCommentsFilter(comments))
+	}()
+	cmdutil.ExitIfError(err)
+
+	_ = history.Add(iss.Key)
+
+	translator := editing.NewAdf2MdTranslator(func(userID string) string {
+		return editing.ResolveUserIDToEmail(userID, client, project)
+	})
+	fmt.Println(issueMarkdown(iss, translator, !noSeparators))
+}
+
+// issueMarkdown joins the description and the comments of the issue in a single
+// markdown document, the comments are preceded by the editor's separator lines
+// if separators is set.
+func issueMarkdown(iss *jira.Issue, translator *adf2md.Translator, separators bool) string {
+	toMarkdown := func(body interface{}) string {
+		switch b := body.(type) {
+		case *adf.ADFNode:
+			return strings.TrimSpace(translator.Translate(b))
+		case string:
+			return strings.TrimSpace(b)
+		default:
+			return ""
+		}
+	}
+
+	out := []string{toMarkdown(iss.Fields.Description)}
+	for _, comment := range iss.Fields.Comment.Comments {
+		if separators {
+			at := bubble.FormatDateTime(comment.Created, jira.RFC3339, "Local")
+			out = append(out, editing.CommentSeparator(comment.Author.GetDisplayableName(), at))
+		}
+		out = append(out, toMarkdown(comment.Body))
+	}
+	return strings.TrimSpace(strings.Join(out, "\n\n"))
+}
+
 func viewPretty(cmd *cobra.Command, args []string) {
 	debug, err := cmd.Flags().GetBool(flagDebug)
 	cmdutil.ExitIfError(err)
@@ -92,16 +217,26 @@ func viewPretty(cmd *cobra.Command, args []string) {
 	comments, err := cmd.Flags().GetUint(flagComments)
 	cmdutil.ExitIfError(err)
 
+	fields, err := cmd.Flags().GetStringSlice(flagFields)
+	cmdutil.ExitIfError(err)
+
 	key := cmdutil.GetJiraIssueKey(viper.GetString(configProject), args[0])
 	iss, err := func() (*jira.Issue, error) {
 		s := cmdutil.Info(messageFetchingData)
 		defer s.Stop()
 
+		opts := []filter.Filter{issue.NewNumCommentsFilter(comments)}
+		if len(fields) > 0 {
+			opts = append(opts, issue.NewFieldsFilter(fields...))
+		}
+
 		client := api.DefaultClient(debug)
-		return api.ProxyGetIssue(client, key, issue.NewNumCommentsFilter(comments))
+		return api.ProxyGetIssue(client, key, opts...)
 	}()
 	cmdutil.ExitIfError(err)
 
+	_ = history.Add(iss.Key)
+
 	plain, err := cmd.Flags().GetBool(flagPlain)
 	cmdutil.ExitIfError(err)
 
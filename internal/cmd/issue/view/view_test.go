@@ -0,0 +1,35 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/md2adf-translator/adf"
+
+	"github.com/jorres/jira-tui/internal/editing"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+func TestIssueMarkdown(t *testing.T) {
+	paragraph := func(s string) *adf.ADFNode {
+		return &adf.ADFNode{Type: "doc", Content: []*adf.ADFNode{
+			{Type: adf.NodeParagraph, Content: []*adf.ADFNode{{Type: adf.ChildNodeText, Text: s}}},
+		}}
+	}
+
+	var iss jira.Issue
+	iss.Fields.Description = paragraph("The description")
+	iss.Fields.Comment.Comments = jira.Comments{
+		{Author: jira.User{DisplayName: "Jane Doe"}, Body: paragraph("First comment"), Created: "2024-01-02T10:00:00.000+0000"},
+		{Author: jira.User{DisplayName: "John Doe"}, Body: "Second comment", Created: "2024-01-03T10:00:00.000+0000"},
+	}
+	translator := editing.NewAdf2MdTranslator(nil)
+
+	out := issueMarkdown(&iss, translator, true)
+	assert.Contains(t, out, "The description\n\n# DO NOT EDIT THIS LINE - Comment by Jane Doe (at ")
+	assert.Contains(t, out, "First comment\n\n# DO NOT EDIT THIS LINE - Comment by John Doe (at ")
+	assert.Regexp(t, `Second comment$`, out)
+
+	assert.Equal(t, "The description\n\nFirst comment\n\nSecond comment", issueMarkdown(&iss, translator, false))
+}
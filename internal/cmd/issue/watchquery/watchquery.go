@@ -0,0 +1,215 @@
+package watchquery
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/api"
+	"github.com/jorres/jira-tui/internal/cmdutil"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+const (
+	helpText = `Watch-query polls a JQL query and notifies about issues that start matching it.
+
+The issues matching the query on start are taken as already seen, every later poll fires a
+desktop notification for each new match. Where desktop notifications aren't available the
+new issues are only printed.
+
+The notification command can be set with watch_query.notify_command in the config, the
+title and the body of the notification are appended to it as the last two arguments.`
+	examples = `$ jira issue watch-query --jql "project = PROJ AND priority = Highest"
+
+# Poll every 5 minutes
+$ jira issue watch-query --jql "assignee = currentUser()" --interval 300
+
+# Use a custom notification command
+$ jira issue watch-query --jql "reporter = currentUser()" --notify-command "notify-send -u critical"`
+
+	defaultInterval = 60
+	minInterval     = 10
+)
+
+// NewCmdWatchQuery is a watch-query command.
+func NewCmdWatchQuery() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "watch-query",
+		Short:   "Notify about new issues matching a JQL",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     watchQuery,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("jql", "", "JQL to watch")
+	cmd.Flags().Int("interval", 0, fmt.Sprintf("Seconds between polls (default %d)", defaultInterval))
+	cmd.Flags().String("notify-command", "", "Command to send notifications with, instead of the OS default")
+
+	_ = cmd.MarkFlagRequired("jql")
+
+	return &cmd
+}
+
+func watchQuery(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	jql, err := cmd.Flags().GetString("jql")
+	cmdutil.ExitIfError(err)
+
+	interval, err := cmd.Flags().GetInt("interval")
+	cmdutil.ExitIfError(err)
+	if interval == 0 {
+		interval = viper.GetInt("watch_query.interval")
+	}
+	if interval == 0 {
+		interval = defaultInterval
+	}
+	if interval < minInterval {
+		cmdutil.Failed("Interval must be at least %d seconds", minInterval)
+	}
+
+	command, err := cmd.Flags().GetString("notify-command")
+	cmdutil.ExitIfError(err)
+	if command == "" {
+		command = viper.GetString("watch_query.notify_command")
+	}
+
+	client := api.DefaultClient(debug)
+	server := viper.GetString("server")
+
+	var total int
+	w := watcher{
+		// Every match is fetched, a single page of an unordered result would
+		// differ from poll to poll and report issues that aren't new.
+		search: func() ([]*jira.Issue, error) {
+			res, err := api.SearchAll(&api.LiveFetcher{Client: client}, jql, 0, api.SearchAllCap)
+			if err != nil {
+				return nil, err
+			}
+			total = res.Total
+			return res.Issues, nil
+		},
+		seen: make(map[string]bool),
+	}
+	notifier := newNotifier(command)
+
+	func() {
+		s := cmdutil.Info("Fetching the issues matching the query...")
+		defer s.Stop()
+
+		_, err = w.poll()
+	}()
+	cmdutil.ExitIfError(err)
+	if total > api.SearchAllCap {
+		cmdutil.Warn("The query matches %d issues, only the first %d are watched", total, api.SearchAllCap)
+	}
+
+	via := "stdout only, no notification command found"
+	if notifier != nil {
+		via = notifier[0]
+	}
+	cmdutil.Success("Watching %d issue(s), polling every %ds (notifications: %s)", len(w.seen), interval, via)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	ticker := time.NewTicker(time.Duration(interval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		issues, err := w.poll()
+		if err != nil {
+			// A failed poll is retried on the next tick, the network may come back.
+			cmdutil.Warn("%s: %s", time.Now().Format(time.TimeOnly), err)
+			continue
+		}
+		for _, iss := range issues {
+			link := cmdutil.GenerateServerBrowseURL(server, iss.Key)
+			fmt.Printf("%s  %s  %s  %s\n", time.Now().Format(time.TimeOnly), iss.Key, iss.Fields.Summary, link)
+
+			if err := notifier.send(iss.Key, iss.Fields.Summary); err != nil {
+				cmdutil.Warn("Unable to send notification: %s", err)
+			}
+		}
+	}
+}
+
+// watcher remembers the issues matching the query between polls.
+type watcher struct {
+	search func() ([]*jira.Issue, error)
+	seen   map[string]bool
+}
+
+// poll runs the search and returns the issues that weren't matching the
+// query on any of the previous polls.
+func (w *watcher) poll() ([]*jira.Issue, error) {
+	issues, err := w.search()
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*jira.Issue
+	for _, iss := range issues {
+		if w.seen[iss.Key] {
+			continue
+		}
+		w.seen[iss.Key] = true
+		out = append(out, iss)
+	}
+	return out, nil
+}
+
+// notifier is the command line that shows a desktop notification, the title
+// and the body are appended to it. A nil notifier only prints to stdout.
+type notifier []string
+
+func newNotifier(command string) notifier {
+	if command != "" {
+		return strings.Fields(command)
+	}
+	return defaultNotifier(runtime.GOOS, exec.LookPath)
+}
+
+// defaultNotifier picks the notification command of the OS, if it's installed.
+func defaultNotifier(goos string, lookPath func(string) (string, error)) notifier {
+	var n notifier
+	switch goos {
+	case "darwin":
+		n = notifier{"osascript", "-e", "on run argv\ndisplay notification (item 2 of argv) with title (item 1 of argv)\nend run"}
+	case "windows":
+		// Windows has no notification command shipping with the OS.
+		return nil
+	default:
+		n = notifier{"notify-send"}
+	}
+	if _, err := lookPath(n[0]); err != nil {
+		return nil
+	}
+	return n
+}
+
+func (n notifier) send(title, body string) error {
+	if len(n) == 0 {
+		return nil
+	}
+	args := append(n[1:len(n):len(n)], title, body)
+	return exec.Command(n[0], args...).Run()
+}
@@ -0,0 +1,72 @@
+package watchquery
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+func TestWatcherPoll(t *testing.T) {
+	var (
+		results [][]*jira.Issue
+		fail    bool
+	)
+	w := watcher{
+		search: func() ([]*jira.Issue, error) {
+			if fail {
+				return nil, errors.New("offline")
+			}
+			res := results[0]
+			results = results[1:]
+			return res, nil
+		},
+		seen: make(map[string]bool),
+	}
+
+	results = [][]*jira.Issue{
+		{{Key: "TEST-1"}, {Key: "TEST-2"}},
+		{{Key: "TEST-3"}, {Key: "TEST-1"}},
+		{{Key: "TEST-1"}},
+		{{Key: "TEST-2"}},
+	}
+
+	issues, err := w.poll()
+	assert.NoError(t, err)
+	assert.Len(t, issues, 2)
+
+	issues, err = w.poll()
+	assert.NoError(t, err)
+	assert.Len(t, issues, 1)
+	assert.Equal(t, "TEST-3", issues[0].Key)
+
+	fail = true
+	_, err = w.poll()
+	assert.Error(t, err)
+	fail = false
+
+	issues, err = w.poll()
+	assert.NoError(t, err)
+	assert.Empty(t, issues)
+
+	// An issue leaving the query and coming back isn't notified again.
+	issues, err = w.poll()
+	assert.NoError(t, err)
+	assert.Empty(t, issues)
+}
+
+func TestNotifier(t *testing.T) {
+	found := func(string) (string, error) { return "/usr/bin/x", nil }
+	missing := func(string) (string, error) { return "", errors.New("not found") }
+
+	assert.Equal(t, notifier{"notify-send"}, defaultNotifier("linux", found))
+	assert.Nil(t, defaultNotifier("linux", missing))
+	assert.Equal(t, "osascript", defaultNotifier("darwin", found)[0])
+	assert.Nil(t, defaultNotifier("windows", found))
+
+	assert.Equal(t, notifier{"notify-send", "-u", "critical"}, newNotifier("notify-send -u critical"))
+
+	assert.NoError(t, notifier(nil).send("TEST-1", "summary"))
+}
@@ -0,0 +1,30 @@
+package query
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/jorres/jira-tui/internal/cmd/query/run"
+	"github.com/jorres/jira-tui/internal/cmd/query/save"
+)
+
+const helpText = `Query manages saved JQL queries. See available commands below.`
+
+// NewCmdQuery is a query command.
+func NewCmdQuery() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "query",
+		Short:       "Query manages saved JQL queries",
+		Long:        helpText,
+		Aliases:     []string{"queries"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        queries,
+	}
+
+	cmd.AddCommand(save.NewCmdSave(), run.NewCmdRun())
+
+	return &cmd
+}
+
+func queries(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,48 @@
+package run
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jorres/jira-tui/internal/cmd/ui"
+	"github.com/jorres/jira-tui/internal/cmdutil"
+	"github.com/jorres/jira-tui/internal/config"
+)
+
+const (
+	helpText = `Run opens the interactive list with the issues matching a saved query.
+
+Press s in the list to open another saved query in a new tab.`
+	examples = `$ jira query run review`
+)
+
+// NewCmdRun is a run command.
+func NewCmdRun() *cobra.Command {
+	return &cobra.Command{
+		Use:     "run NAME",
+		Short:   "Open the interactive list for a saved query",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.ExactArgs(1),
+		Run:     run,
+	}
+}
+
+func run(cmd *cobra.Command, args []string) {
+	q, ok := config.GetSavedQuery(args[0])
+	if !ok {
+		queries := config.SavedQueries()
+		if len(queries) == 0 {
+			cmdutil.Failed("No saved query %q, save one with `jira query save %s --jql ...`", args[0], args[0])
+		}
+
+		names := make([]string, 0, len(queries))
+		for _, q := range queries {
+			names = append(names, q.Name)
+		}
+		cmdutil.Failed("No saved query %q\nAvailable queries are: %s", args[0], strings.Join(names, ", "))
+	}
+
+	ui.RunQuery(cmd, q.Name, q.JQL)
+}
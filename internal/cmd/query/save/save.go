@@ -0,0 +1,48 @@
+package save
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/jorres/jira-tui/internal/cmdutil"
+	"github.com/jorres/jira-tui/internal/config"
+)
+
+const (
+	helpText = `Save stores a JQL query under a name in the config file.
+
+Saving a query with an existing name replaces it.`
+	examples = `$ jira query save review --jql "status = 'In Review' AND assignee = currentUser()"
+
+# Open the interactive list with the issues matching the query
+$ jira query run review`
+)
+
+// NewCmdSave is a save command.
+func NewCmdSave() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "save NAME",
+		Short:   "Save a JQL query under a name",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.ExactArgs(1),
+		Run:     save,
+	}
+
+	cmd.Flags().String("jql", "", "JQL query to save")
+	_ = cmd.MarkFlagRequired("jql")
+
+	return &cmd
+}
+
+func save(cmd *cobra.Command, args []string) {
+	jql, err := cmd.Flags().GetString("jql")
+	cmdutil.ExitIfError(err)
+
+	if jql == "" {
+		cmdutil.Failed("JQL query can't be empty")
+	}
+
+	cmdutil.ExitIfError(config.SaveQuery(args[0], jql))
+
+	cmdutil.Success("Query %q saved, run it with `jira query run %s`", args[0], args[0])
+}
@@ -4,11 +4,16 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/jorres/jira-tui/api"
+	"github.com/jorres/jira-tui/internal/cmd/auth"
 	"github.com/jorres/jira-tui/internal/cmd/board"
 	"github.com/jorres/jira-tui/internal/cmd/completion"
+	configCmd "github.com/jorres/jira-tui/internal/cmd/config"
+	debugCmd "github.com/jorres/jira-tui/internal/cmd/debug"
 	"github.com/jorres/jira-tui/internal/cmd/epic"
 	initCmd "github.com/jorres/jira-tui/internal/cmd/init"
 	"github.com/jorres/jira-tui/internal/cmd/issue"
@@ -16,6 +21,7 @@ import (
 	"github.com/jorres/jira-tui/internal/cmd/me"
 	"github.com/jorres/jira-tui/internal/cmd/open"
 	"github.com/jorres/jira-tui/internal/cmd/project"
+	"github.com/jorres/jira-tui/internal/cmd/query"
 	"github.com/jorres/jira-tui/internal/cmd/serverinfo"
 	"github.com/jorres/jira-tui/internal/cmd/sprint"
 	"github.com/jorres/jira-tui/internal/cmd/ui"
@@ -73,6 +79,10 @@ func NewCmdRoot() *cobra.Command {
 			return cmd.Help()
 		},
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			if !cmdutil.ColorEnabled() {
+				color.NoColor = true
+			}
+
 			subCmd := cmd.Name()
 			if !cmdRequireToken(subCmd) {
 				return
@@ -87,6 +97,10 @@ func NewCmdRoot() *cobra.Command {
 			if !jiraConfig.Exists(configFile) {
 				cmdutil.Failed("Missing configuration file.\nRun 'jira init' to configure the tool.")
 			}
+
+			if verify, _ := cmd.Flags().GetBool("verify-auth"); verify {
+				verifyAuth()
+			}
 		},
 	}
 
@@ -107,12 +121,15 @@ func NewCmdRoot() *cobra.Command {
 		),
 	)
 	cmd.PersistentFlags().BoolVar(&debug, "debug", false, "Turn on debug output")
+	cmd.PersistentFlags().Bool("no-color", false, "Disable colored output, same as setting NO_COLOR")
+	cmd.PersistentFlags().Bool("verify-auth", false, "Check the credentials with Jira before running the command")
 
 	cmd.SetHelpFunc(helpFunc)
 
 	_ = viper.BindPFlag("config", cmd.PersistentFlags().Lookup("config"))
 	_ = viper.BindPFlag("project.key", cmd.PersistentFlags().Lookup("project"))
 	_ = viper.BindPFlag("debug", cmd.PersistentFlags().Lookup("debug"))
+	_ = viper.BindPFlag("no_color", cmd.PersistentFlags().Lookup("no-color"))
 
 	addChildCommands(&cmd)
 
@@ -122,11 +139,15 @@ func NewCmdRoot() *cobra.Command {
 func addChildCommands(cmd *cobra.Command) {
 	cmd.AddCommand(
 		initCmd.NewCmdInit(),
+		auth.NewCmdAuth(),
 		issue.NewCmdIssue(),
 		epic.NewCmdEpic(),
 		sprint.NewCmdSprint(),
 		board.NewCmdBoard(),
 		project.NewCmdProject(),
+		query.NewCmdQuery(),
+		configCmd.NewCmdConfig(),
+		debugCmd.NewCmdDebug(),
 		open.NewCmdOpen(),
 		me.NewCmdMe(),
 		serverinfo.NewCmdServerInfo(),
@@ -137,6 +158,21 @@ func addChildCommands(cmd *cobra.Command) {
 	)
 }
 
+// verifyAuth fails early with an explanation if Jira rejects the credentials.
+func verifyAuth() {
+	me, err := func() (*jira.Me, error) {
+		s := cmdutil.Info("Verifying credentials...")
+		defer s.Stop()
+
+		return api.VerifyAuth(debug)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if debug {
+		cmdutil.Success("Authenticated as %s", me.Name)
+	}
+}
+
 func cmdRequireToken(cmd string) bool {
 	allowList := []string{
 		"init",
@@ -145,6 +181,11 @@ func cmdRequireToken(cmd string) bool {
 		"version",
 		"completion",
 		"man",
+		"path",
+		// Managing the token must work before there is one.
+		"auth",
+		"set",
+		"clear",
 	}
 
 	for _, item := range allowList {
@@ -166,7 +207,7 @@ func checkForJiraToken(server string, login string) {
 		return
 	}
 
-	secret, _ := keyring.Get("jira-cli", login)
+	secret, _ := keyring.Get(api.KeyringService, login)
 	if secret != "" {
 		return
 	}
@@ -179,6 +220,7 @@ For local server: you can use the password you use to log in to Jira for basic a
 After generating the token, you can either:
   - Export API token to your shell as a JIRA_API_TOKEN env variable
   - Or, you can use a .netrc file to define required machine details
+  - Or, you can store it in the system keyring with 'jira auth set'
 
 Once you are done with the above steps, run 'jira init' to generate the config if you haven't already.
 
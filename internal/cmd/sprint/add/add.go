@@ -2,6 +2,7 @@ package add
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -11,11 +12,19 @@ import (
 	"github.com/jorres/jira-tui/api"
 	"github.com/jorres/jira-tui/internal/cmdutil"
 	"github.com/jorres/jira-tui/internal/query"
+	"github.com/jorres/jira-tui/pkg/jira"
 )
 
 const (
-	helpText = `Add issues to sprint.`
-	examples = `$ jira sprint add SPRINT_ID ISSUE-1 ISSUE-2`
+	helpText = `Add issues to sprint.
+
+Issues are sent in batches of 50, the most the API accepts in one request.
+If some batches fail the issues that were added are listed along with
+the errors and the command exits with status 1.`
+	examples = `$ jira sprint add SPRINT_ID ISSUE-1 ISSUE-2 ISSUE-3`
+
+	// batchSize is the most issues the agile API moves to a sprint at once.
+	batchSize = 50
 )
 
 // NewCmdAdd is an add command.
@@ -28,7 +37,7 @@ func NewCmdAdd() *cobra.Command {
 		Aliases: []string{"assign"},
 		Annotations: map[string]string{
 			"help:args": "SPRINT_ID\t\tID of the sprint on which you want to assign issues to, eg: 123\n" +
-				"ISSUE-1 [...ISSUE-N]\tKey of the issues to add to the sprint (max 50 issues at once)",
+				"ISSUE-1 [...ISSUE-N]\tKey of the issues to add to the sprint",
 		},
 		Run: add,
 	}
@@ -62,15 +71,53 @@ func add(cmd *cobra.Command, args []string) {
 		}
 	}
 
-	err := func() error {
+	added, err := func() ([]string, error) {
 		s := cmdutil.Info("Adding issues to the sprint...")
 		defer s.Stop()
 
-		return client.SprintIssuesAdd(params.sprintID, params.issues...)
+		return addInBatches(params.issues, func(issues ...string) error {
+			return client.SprintIssuesAdd(params.sprintID, issues...)
+		})
 	}()
+
+	if err == nil {
+		cmdutil.Success(fmt.Sprintf("Issues added to the sprint %s\n%s", params.sprintID, cmdutil.GenerateServerBrowseURL(server, project)))
+		return
+	}
+	if len(added) > 0 {
+		cmdutil.Warn("Only %d of %d issues were added to the sprint %s: %s",
+			len(added), len(params.issues), params.sprintID, strings.Join(added, ", "))
+	}
 	cmdutil.ExitIfError(err)
+}
+
+// addInBatches sends the issues to add in batches of batchSize. A failed batch
+// doesn't stop the following ones, the issues of the batches that went through
+// are returned along with the errors of the others.
+func addInBatches(issues []string, add func(...string) error) ([]string, error) {
+	if len(issues) <= batchSize {
+		if err := add(issues...); err != nil {
+			return nil, err
+		}
+		return issues, nil
+	}
+
+	var (
+		added  []string
+		failed strings.Builder
+	)
+	for batch := range slices.Chunk(issues, batchSize) {
+		if err := add(batch...); err != nil {
+			failed.WriteString(fmt.Sprintf("\n  - %s..%s: %s", batch[0], batch[len(batch)-1], cmdutil.NormalizeJiraError(err.Error())))
+			continue
+		}
+		added = append(added, batch...)
+	}
 
-	cmdutil.Success(fmt.Sprintf("Issues added to the sprint %s\n%s", params.sprintID, cmdutil.GenerateServerBrowseURL(server, project)))
+	if failed.Len() > 0 {
+		return added, &jira.ErrMultipleFailed{Msg: failed.String()}
+	}
+	return added, nil
 }
 
 func parseFlags(flags query.FlagParser, args []string, project string) *addParams {
@@ -0,0 +1,40 @@
+package add
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+func TestAddInBatches(t *testing.T) {
+	issues := make([]string, 0, 120)
+	for i := 1; i <= 120; i++ {
+		issues = append(issues, fmt.Sprintf("TEST-%d", i))
+	}
+
+	var batches [][]string
+	added, err := addInBatches(issues, func(batch ...string) error {
+		batches = append(batches, batch)
+		if slices.Contains(batch, "TEST-60") {
+			return errors.New("issue TEST-60 does not exist")
+		}
+		return nil
+	})
+
+	assert.Len(t, batches, 3)
+	assert.Len(t, batches[2], 20)
+	assert.Equal(t, append(issues[:50:50], issues[100:]...), added)
+
+	var failed *jira.ErrMultipleFailed
+	assert.ErrorAs(t, err, &failed)
+	assert.Contains(t, failed.Msg, "TEST-51..TEST-100")
+
+	added, err = addInBatches(issues[:2], func(...string) error { return nil })
+	assert.NoError(t, err)
+	assert.Equal(t, issues[:2], added)
+}
@@ -0,0 +1,90 @@
+package current
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/api"
+	"github.com/jorres/jira-tui/internal/cmdutil"
+	"github.com/jorres/jira-tui/internal/view"
+	"github.com/jorres/jira-tui/pkg/jira"
+	"github.com/jorres/jira-tui/pkg/jql"
+)
+
+const (
+	maxResults = api.SearchPageSize
+	helpText   = `Current shows the active sprint of the configured board with its issues
+grouped by status and the number of days left until the sprint ends.`
+	examples = `$ jira sprint current`
+)
+
+// NewCmdCurrent is a sprint current command.
+func NewCmdCurrent() *cobra.Command {
+	return &cobra.Command{
+		Use:     "current",
+		Short:   "Show issues of the active sprint grouped by status",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"active"},
+		Args:    cobra.NoArgs,
+		Run:     current,
+	}
+}
+
+func current(cmd *cobra.Command, _ []string) {
+	project := viper.GetString("project.key")
+	boardID := viper.GetInt("board.id")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+
+	sprints, err := func() ([]*jira.Sprint, error) {
+		s := cmdutil.Info("Fetching active sprint...")
+		defer s.Stop()
+
+		resp, err := client.Sprints(boardID, fmt.Sprintf("state=%s", jira.SprintStateActive), 0, maxResults)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Sprints, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(sprints) == 0 {
+		cmdutil.Failed("No active sprint found on board %d", boardID)
+		return
+	}
+
+	for i, sprint := range sprints {
+		issues, err := func() ([]*jira.Issue, error) {
+			s := cmdutil.Info("Fetching sprint issues...")
+			defer s.Stop()
+
+			// Page through the issues, a sprint easily holds more than a page.
+			var issues []*jira.Issue
+			for len(issues) < api.SearchAllCap {
+				resp, err := client.SprintIssues(sprint.ID, jql.NewJQL(project).String(), uint(len(issues)), maxResults)
+				if err != nil {
+					return nil, err
+				}
+				issues = append(issues, resp.Issues...)
+				if len(resp.Issues) == 0 || len(issues) >= resp.Total {
+					break
+				}
+			}
+			return issues, nil
+		}()
+		cmdutil.ExitIfError(err)
+
+		if i > 0 {
+			fmt.Println()
+		}
+
+		v := view.ActiveSprint{Sprint: sprint, Data: issues, Timezone: viper.GetString("timezone")}
+		cmdutil.ExitIfError(v.Render())
+	}
+}
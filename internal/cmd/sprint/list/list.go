@@ -104,7 +104,7 @@ func singleSprintView(sprintQuery *query.Sprint, flags query.FlagParser, boardID
 		if sprintQuery.Params().ShowAllIssues {
 			q.Params().JQL = "project IS NOT EMPTY"
 		}
-		resp, err := client.SprintIssues(sprintID, q.Get(), q.Params().From, q.Params().Limit)
+		resp, err := client.SprintIssues(sprintID, q.GetForBoard(), q.Params().From, q.Params().Limit)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -266,7 +266,7 @@ func getIssueQuery(project string, flags query.FlagParser, showAll bool) (string
 	if showAll {
 		q.Params().JQL = "project IS NOT EMPTY"
 	}
-	return q.Get(), nil
+	return q.GetForBoard(), nil
 }
 
 func setFlags(cmd *cobra.Command) {
@@ -289,6 +289,7 @@ func hideFlags(cmd *cobra.Command) {
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("watching"))
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("type"))
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("resolution"))
+	cmdutil.ExitIfError(cmd.Flags().MarkHidden("open"))
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("status"))
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("priority"))
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("reporter"))
@@ -299,6 +300,8 @@ func hideFlags(cmd *cobra.Command) {
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("updated-after"))
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("created-before"))
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("updated-before"))
+	cmdutil.ExitIfError(cmd.Flags().MarkHidden("created-since"))
+	cmdutil.ExitIfError(cmd.Flags().MarkHidden("updated-since"))
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("label"))
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("reverse"))
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/jorres/jira-tui/internal/cmd/sprint/add"
 	"github.com/jorres/jira-tui/internal/cmd/sprint/close"
+	"github.com/jorres/jira-tui/internal/cmd/sprint/current"
 	"github.com/jorres/jira-tui/internal/cmd/sprint/list"
 )
 
@@ -24,8 +25,9 @@ func NewCmdSprint() *cobra.Command {
 	lc := list.NewCmdList()
 	ac := add.NewCmdAdd()
 	cc := close.NewCmdClose()
+	cur := current.NewCmdCurrent()
 
-	cmd.AddCommand(lc, ac, cc)
+	cmd.AddCommand(lc, ac, cc, cur)
 
 	list.SetFlags(lc)
 
@@ -2,8 +2,11 @@ package ui
 
 import (
 	"fmt"
+	"os"
 	"strings"
+	"sync"
 
+	"github.com/charmbracelet/colorprofile"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -11,6 +14,7 @@ import (
 	"github.com/jorres/jira-tui/internal/bubble"
 	"github.com/jorres/jira-tui/internal/cmdutil"
 	D "github.com/jorres/jira-tui/internal/debug"
+	"github.com/jorres/jira-tui/internal/history"
 	"github.com/jorres/jira-tui/internal/query"
 	"github.com/jorres/jira-tui/pkg/jira"
 )
@@ -43,6 +47,12 @@ func ui(cmd *cobra.Command, args []string) {
 	debug, err := cmd.Flags().GetBool("debug")
 	cmdutil.ExitIfError(err)
 
+	fixtures, err := cmd.Flags().GetString("fixtures")
+	cmdutil.ExitIfError(err)
+	if fixtures != "" {
+		cmdutil.ExitIfError(api.UseFixtures(fixtures))
+	}
+
 	// Read tab configuration from viper
 	var tabConfigs []ListTabConfig
 	err = viper.UnmarshalKey("ui.list.tabs", &tabConfigs)
@@ -61,69 +71,201 @@ func ui(cmd *cobra.Command, args []string) {
 	}()
 	timezone := viper.GetString("timezone")
 
-	projectType := viper.GetString("project.type")
-	epicQ := query.NewDefaultIssue(project, cmd.Flags())
-	if projectType == jira.ProjectTypeNextGen {
-		epicQ.Params().IssueType = viper.GetString("next_gen.epic_task_name")
+	limit, all := tabLimit(cmd)
+
+	// newTabQuery creates the query of the default tab, --limit and --all
+	// override its limit.
+	newTabQuery := func(tabProject string) *query.Issue {
+		q := query.NewDefaultIssue(tabProject, cmd.Flags())
+		if limit > 0 {
+			q.Params().Limit = limit
+		}
+		return q
 	}
-	epicQ.Params().Status = []string{}
-	epicQ.Params().Assignee = ""
-	fetchAllEpics := MakeFetcherFromQuery(epicQ, debug)
+
+	fetchAllEpics := makeEpicFetcher(project, cmd.Flags(), debug)
 
 	var tabs []*bubble.TabConfig
 	var total int
 
 	if len(tabConfigs) <= 1 {
-		q := query.NewDefaultIssue(project, cmd.Flags())
+		q := newTabQuery(project)
 		fetchIssuesWithArgs := MakeFetcherFromQuery(q, debug)
 
-		_, total = fetchIssuesWithArgs()
+		_, total, err = fetchIssuesWithArgs()
+		cmdutil.ExitIfError(err)
 
 		if total == 0 {
 			fmt.Println()
 			cmdutil.Failed("No result found for given query in project %q", project)
 			return
 		}
+		if all && total > api.SearchAllCap {
+			cmdutil.Warn("The query matches %d issues, only the first %d are listed", total, api.SearchAllCap)
+		}
 
 		// Use the default board ID from config for single tab
 		defaultBoardId := viper.GetInt("board.id")
 
-		tabs = []*bubble.TabConfig{
-			{
-				Project:     project,
+		var makeTab func(tabProject string) *bubble.TabConfig
+		makeTab = func(tabProject string) *bubble.TabConfig {
+			tab := &bubble.TabConfig{
+				Project:     tabProject,
 				Name:        "Issues",
 				Columns:     columnsList,
-				BoardId:     defaultBoardId,
 				QueryParams: &query.IssueParams{},
-				FetchIssues: fetchIssuesWithArgs,
-				FetchEpics:  fetchAllEpics,
-			},
+				FetchIssues: MakeFetcherFromQuery(newTabQuery(tabProject), debug),
+				FetchEpics:  makeEpicFetcher(tabProject, cmd.Flags(), debug),
+				ForProject:  makeTab,
+				FetchFiltered: func(jql string) ([]*jira.Issue, int, error) {
+					q := newTabQuery(tabProject)
+					q.Params().JQL = andJQL(q.Params().JQL, jql)
+					return MakeFetcherFromQuery(q, debug)()
+				},
+			}
+			// The configured board belongs to the configured project
+			if tabProject == project {
+				tab.BoardId = defaultBoardId
+			}
+			if all {
+				tab.FetchMore = func(jql string, from uint) ([]*jira.Issue, error) {
+					q := newTabQuery(tabProject)
+					if jql != "" {
+						q.Params().JQL = andJQL(q.Params().JQL, jql)
+					}
+					q.Params().From = from
+					issues, _, err := MakeFetcherFromQuery(q, debug)()
+					return issues, err
+				}
+			}
+			return tab
 		}
+
+		tabs = []*bubble.TabConfig{makeTab(project)}
 	} else {
 		tabs = make([]*bubble.TabConfig, len(tabConfigs))
 		total = 0
 
 		for i, tabConfig := range tabConfigs {
-			tabProject := project
-			if tabConfig.Project != "" {
-				tabProject = tabConfig.Project
+			if limit > 0 {
+				tabConfig.Limit = limit
+			}
+			if tabConfig.Filter != 0 {
+				cmdutil.ExitIfError(resolveTabFilter(&tabConfig, debug))
 			}
 
-			fetchIssues := MakeFetcherFromTabConfig(tabProject, cmd.Flags(), tabConfig, debug)
+			configuredProject := project
+			if tabConfig.Project != "" {
+				configuredProject = tabConfig.Project
+			}
 
-			tabs[i] = &bubble.TabConfig{
-				Project:     tabProject,
-				Name:        tabConfig.Name,
-				Columns:     tabConfig.Columns,
-				BoardId:     tabConfig.BoardId,
-				QueryParams: &tabConfig.IssueParams,
-				FetchIssues: fetchIssues,
-				FetchEpics:  fetchAllEpics,
+			var makeTab func(tabProject string) *bubble.TabConfig
+			makeTab = func(tabProject string) *bubble.TabConfig {
+				tab := &bubble.TabConfig{
+					Project:     tabProject,
+					Name:        tabConfig.Name,
+					Columns:     tabConfig.Columns,
+					QueryParams: &tabConfig.IssueParams,
+					FetchIssues: MakeFetcherFromTabConfig(tabProject, cmd.Flags(), tabConfig, debug),
+					FetchEpics:  fetchAllEpics,
+					ForProject:  makeTab,
+					FetchFiltered: func(jql string) ([]*jira.Issue, int, error) {
+						filtered := tabConfig
+						filtered.JQL = andJQL(tabConfig.JQL, jql)
+						return MakeFetcherFromTabConfig(tabProject, cmd.Flags(), filtered, debug)()
+					},
+				}
+				if tabProject == configuredProject {
+					tab.BoardId = tabConfig.BoardId
+				} else {
+					tab.FetchEpics = makeEpicFetcher(tabProject, cmd.Flags(), debug)
+				}
+				if all {
+					tab.FetchMore = func(jql string, from uint) ([]*jira.Issue, error) {
+						page := tabConfig
+						if jql != "" {
+							page.JQL = andJQL(tabConfig.JQL, jql)
+						}
+						page.From = from
+						issues, _, err := MakeFetcherFromTabConfig(tabProject, cmd.Flags(), page, debug)()
+						return issues, err
+					}
+				}
+				return tab
 			}
+
+			tabs[i] = makeTab(configuredProject)
+		}
+	}
+
+	if recentTab := makeRecentTab(project, fetchAllEpics, debug); recentTab != nil {
+		tabs = append(tabs, recentTab)
+	}
+
+	if fixtures != "" {
+		// Board state and projects are fetched straight from Jira, there is nothing to show offline.
+		for _, tab := range tabs {
+			tab.BoardId = 0
+			tab.ForProject = nil
+			tab.FetchFiltered = nil
 		}
 	}
 
-	bubble.RunMainUI(project, server, total, tabs, timezone, debug)
+	if snapshot, width, height := snapshotSize(cmd); snapshot {
+		frame, err := bubble.Snapshot(project, server, total, tabs, debug, fixtures != "", width, height)
+		cmdutil.ExitIfError(err)
+
+		// Colors are kept when piped, to be turned into an image, unless disabled.
+		out := &colorprofile.Writer{Forward: os.Stdout, Profile: colorprofile.TrueColor}
+		if !cmdutil.ColorEnabled() {
+			out.Profile = colorprofile.NoTTY
+		}
+		_, _ = fmt.Fprintln(out, frame)
+		return
+	}
+
+	bubble.RunMainUI(project, server, total, tabs, timezone, debug, fixtures != "")
+}
+
+// snapshotSize tells if a snapshot is asked for with --snapshot, and its size
+// as given with --snapshot-size, e.g. 120x40.
+func snapshotSize(cmd *cobra.Command) (bool, int, int) {
+	snapshot, err := cmd.Flags().GetBool("snapshot")
+	cmdutil.ExitIfError(err)
+	if !snapshot {
+		return false, 0, 0
+	}
+
+	size, err := cmd.Flags().GetString("snapshot-size")
+	cmdutil.ExitIfError(err)
+
+	var width, height int
+	if _, err := fmt.Sscanf(size, "%dx%d", &width, &height); err != nil || width <= 0 || height <= 0 {
+		cmdutil.Failed("Invalid snapshot size %q, expected WIDTHxHEIGHT, eg: 120x40", size)
+	}
+	return true, width, height
+}
+
+// RunQuery opens the interactive list scoped to a saved query.
+func RunQuery(cmd *cobra.Command, name, jql string) {
+	server := viper.GetString("server")
+	project := viper.GetString("project.key")
+	timezone := viper.GetString("timezone")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	tab := bubble.NewQueryTab(project, name, jql, makeEpicFetcher(project, cmd.Flags(), debug), debug)
+
+	_, total, err := tab.FetchIssues()
+	cmdutil.ExitIfError(err)
+	if total == 0 {
+		fmt.Println()
+		cmdutil.Failed("No result found for query %q", name)
+		return
+	}
+
+	bubble.RunMainUI(project, server, total, []*bubble.TabConfig{tab}, timezone, debug, false)
 }
 
 type ListTabConfig struct {
@@ -131,12 +273,109 @@ type ListTabConfig struct {
 	Project           string   `mapstructure:"project"`
 	Columns           []string `mapstructure:"columns"`
 	BoardId           int      `mapstructure:"boardId"`
+	Filter            int      `mapstructure:"filter"`
 	query.IssueParams `mapstructure:",squash"`
 }
 
+// resolveTabFilter narrows the tab down to the issues of the saved filter it
+// references. The filter is checked to exist upfront, its name is the default
+// name of the tab.
+func resolveTabFilter(tabConfig *ListTabConfig, debug bool) error {
+	name := fmt.Sprintf("Filter %d", tabConfig.Filter)
+	if !api.IsUsingFixtures() {
+		f, err := api.DefaultClient(debug).GetFilter(tabConfig.Filter)
+		if err != nil {
+			return fmt.Errorf("unable to resolve filter %d: %w", tabConfig.Filter, err)
+		}
+		name = f.Name
+	}
+
+	if tabConfig.Name == "" {
+		tabConfig.Name = name
+	}
+	tabConfig.JQL = andJQL(fmt.Sprintf("filter = %d", tabConfig.Filter), tabConfig.JQL)
+	return nil
+}
+
+// makeRecentTab creates a synthetic tab listing recently opened issues, newest first.
+// It is skipped if there is no history yet or if disabled with ui.list.recent_tab.
+func makeRecentTab(project string, fetchEpics func() ([]*jira.Issue, int, error), debug bool) *bubble.TabConfig {
+	if viper.IsSet("ui.list.recent_tab") && !viper.GetBool("ui.list.recent_tab") {
+		return nil
+	}
+	if keys, err := history.Load(); err != nil || len(keys) == 0 {
+		return nil
+	}
+
+	return &bubble.TabConfig{
+		Project:     project,
+		Name:        "Recent",
+		QueryParams: &query.IssueParams{},
+		FetchIssues: MakeFetcherFromHistory(debug),
+		FetchEpics:  fetchEpics,
+	}
+}
+
+// andJQL narrows the query down with another JQL clause.
+func andJQL(q, clause string) string {
+	if q == "" {
+		return clause
+	}
+	return fmt.Sprintf("(%s) AND (%s)", q, clause)
+}
+
+// makeEpicFetcher creates a fetcher function returning all epics of the project.
+func makeEpicFetcher(project string, flags query.FlagParser, debug bool) func() ([]*jira.Issue, int, error) {
+	epicQ := query.NewDefaultIssue(project, flags)
+	if viper.GetString("project.type") == jira.ProjectTypeNextGen {
+		epicQ.Params().IssueType = viper.GetString("next_gen.epic_task_name")
+	}
+	epicQ.Params().Status = []string{}
+	epicQ.Params().Assignee = ""
+	return MakeFetcherFromQuery(epicQ, debug)
+}
+
+// MakeFetcherFromHistory creates a fetcher function returning recently opened issues.
+// Issues that can't be fetched anymore, e.g. deleted ones, are skipped.
+func MakeFetcherFromHistory(debug bool) func() ([]*jira.Issue, int, error) {
+	return func() ([]*jira.Issue, int, error) {
+		keys, err := history.Load()
+		if err != nil {
+			return nil, 0, err
+		}
+
+		fetched := make([]*jira.Issue, len(keys))
+
+		var wg sync.WaitGroup
+		for i, key := range keys {
+			wg.Add(1)
+			go func(i int, key string) {
+				defer wg.Done()
+
+				iss, err := api.DefaultFetcher(debug).GetIssue(key)
+				if err != nil {
+					D.Debug("unable to fetch recent issue", key, err)
+					return
+				}
+				fetched[i] = iss
+			}(i, key)
+		}
+		wg.Wait()
+
+		issues := make([]*jira.Issue, 0, len(fetched))
+		for _, iss := range fetched {
+			if iss != nil {
+				issues = append(issues, iss)
+			}
+		}
+
+		return issues, len(issues), nil
+	}
+}
+
 // MakeFetcherFromTabConfig creates a fetcher function from a tab configuration
-func MakeFetcherFromTabConfig(project string, baseFlags query.FlagParser, tabConfig ListTabConfig, debug bool) func() ([]*jira.Issue, int) {
-	return func() ([]*jira.Issue, int) {
+func MakeFetcherFromTabConfig(project string, baseFlags query.FlagParser, tabConfig ListTabConfig, debug bool) func() ([]*jira.Issue, int, error) {
+	return func() ([]*jira.Issue, int, error) {
 		// Replace the entire params with our config, but preserve defaults
 		params := tabConfig.IssueParams
 		if params.OrderBy == "" {
@@ -153,48 +392,96 @@ func MakeFetcherFromTabConfig(project string, baseFlags query.FlagParser, tabCon
 		params.Project = project
 		q.SetParams(&params)
 
-		issues, total, err := func() ([]*jira.Issue, int, error) {
-			resp, err := api.ProxySearch(api.DefaultClient(debug), q.Get(), q.Params().From, q.Params().Limit)
-			if err != nil {
-				return nil, 0, err
-			}
-
-			return resp.Issues, resp.Total, nil
-		}()
+		resp, err := search(q, debug)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Issues, resp.Total, nil
+	}
+}
 
-		cmdutil.ExitIfError(err)
-		return issues, total
+func MakeFetcherFromQuery(q *query.Issue, debug bool) func() ([]*jira.Issue, int, error) {
+	return func() ([]*jira.Issue, int, error) {
+		D.Debug("limit", q.Params().Limit)
+		resp, err := search(q, debug)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Issues, resp.Total, nil
 	}
 }
 
-func MakeFetcherFromQuery(q *query.Issue, debug bool) func() ([]*jira.Issue, int) {
-	return func() ([]*jira.Issue, int) {
-		issues, total, err := func() ([]*jira.Issue, int, error) {
-			D.Debug("limit", q.Params().Limit)
-			resp, err := api.ProxySearch(api.DefaultClient(debug), q.Get(), q.Params().From, q.Params().Limit)
-			if err != nil {
-				return nil, 0, err
-			}
+// search runs the query. Children of an epic in a classic project are not matched
+// by the parent clause, so such queries go through the agile epic endpoint instead,
+// the same way `jira epic list KEY` does.
+func search(q *query.Issue, debug bool) (*jira.SearchResult, error) {
+	parent := q.Params().Parent
+	if parent == "" || api.IsUsingFixtures() || viper.GetString("project.type") == jira.ProjectTypeNextGen {
+		return api.SearchAll(api.DefaultFetcher(debug), q.Get(), q.Params().From, q.Params().Limit)
+	}
+
+	client := api.DefaultClient(debug)
 
-			// TODO @jorres we lost an ability to query epics here, see `epic list` command, it would fail in case of non-next-gen project
+	epic, ok := epicKey(client, parent)
+	if !ok {
+		return api.ProxySearch(client, q.Get(), q.Params().From, q.Params().Limit)
+	}
 
-			// 	var resp *jira.SearchResult
-			// 	if projectType == jira.ProjectTypeNextGen {
-			// 		q.Params().Parent = key
-			// 		q.Params().IssueType = viper.GetString("next_gen.epic_task_name")
+	params := *q.Params()
+	params.Parent = ""
 
-			// 		resp, err = client.Search(q.Get(), q.Params().From, q.Params().Limit)
-			// 	} else {
-			// 		resp, err = client.EpicIssues(key, q.Get(), q.Params().From, q.Params().Limit)
-			// 	}
+	eq := &query.Issue{Flags: q.Flags}
+	eq.SetParams(&params)
 
-			return resp.Issues, resp.Total, nil
-		}()
+	return client.EpicIssues(epic, eq.GetForBoard(), params.From, params.Limit)
+}
 
-		cmdutil.ExitIfError(err)
+// epicKeys caches the issues tabs are narrowed down to with --parent, the key of
+// the epic or "" if the parent isn't one, so that refreshing a tab doesn't look
+// the parent up again.
+var epicKeys = struct {
+	sync.Mutex
+	m map[string]string
+}{m: make(map[string]string)}
+
+// epicKey returns the key of the parent if it is an epic. Parents that can't be
+// fetched are looked up again on the next search.
+func epicKey(client *jira.Client, parent string) (string, bool) {
+	epicKeys.Lock()
+	key, ok := epicKeys.m[parent]
+	epicKeys.Unlock()
+	if ok {
+		return key, key != ""
+	}
 
-		return issues, total
+	iss, err := api.ProxyGetIssue(client, parent)
+	if err != nil {
+		return "", false
+	}
+	if strings.EqualFold(iss.Fields.IssueType.Name, jira.IssueTypeEpic) {
+		key = iss.Key
 	}
+
+	epicKeys.Lock()
+	epicKeys.m[parent] = key
+	epicKeys.Unlock()
+	return key, key != ""
+}
+
+// tabLimit returns the number of issues listed in a tab as set with --limit or
+// --all, zero keeps the defaults. With --all only the first page is fetched
+// upfront, the returned flag tells that the rest is paged in as the list is
+// scrolled, see bubble.TabConfig.FetchMore.
+func tabLimit(cmd *cobra.Command) (uint, bool) {
+	all, err := cmd.Flags().GetBool("all")
+	cmdutil.ExitIfError(err)
+	if all {
+		return api.SearchPageSize, true
+	}
+
+	limit, err := cmd.Flags().GetUint("limit")
+	cmdutil.ExitIfError(err)
+	return limit, false
 }
 
 // SetFlags sets flags supported by a list command.
@@ -202,6 +489,19 @@ func SetFlags(cmd *cobra.Command) {
 	cmd.Flags().SortFlags = false
 
 	cmd.Flags().String("columns", "", "Comma separated list of columns to display in the plain mode.\n"+
-		fmt.Sprintf("Accepts: %s", strings.Join(bubble.ValidIssueColumns(), ", ")))
+		fmt.Sprintf("Accepts: %s and custom fields by id or configured name", strings.Join(bubble.ValidIssueColumns(), ", ")))
 	cmd.Flags().Uint("fixed-columns", 1, "Number of fixed columns in the interactive mode")
+	cmd.Flags().String("fixtures", "", "Read-only demo mode: serve issues from JSON fixtures in the given directory instead of Jira.\n"+
+		"Expects search.json (GET /search response) and optionally issue/<KEY>.json (GET /issue/<KEY> response)")
+	cmd.Flags().Bool("no-truncate", false, "Let the summary column expand up to ui.list.summary_max_width before truncating")
+	cmd.Flags().Bool("since-last-viewed", false, "Mark the comments added since an issue was last opened as new")
+	cmd.Flags().Uint("limit", 0, "Number of issues listed in each tab, fetched in pages of 100")
+	cmd.Flags().Bool("all", false, fmt.Sprintf("List every matching issue in each tab, up to %d", api.SearchAllCap))
+	cmd.MarkFlagsMutuallyExclusive("limit", "all")
+	cmd.Flags().Bool("snapshot", false, "Print a single frame of the UI once the issues are loaded and exit, eg: to check layout changes.\n"+
+		"Combine with --fixtures for a reproducible output")
+	cmd.Flags().String("snapshot-size", "120x40", "Size of the --snapshot frame, WIDTHxHEIGHT")
+
+	_ = viper.BindPFlag("ui.list.no_truncate", cmd.Flags().Lookup("no-truncate"))
+	_ = viper.BindPFlag("ui.issue.since_last_viewed", cmd.Flags().Lookup("since-last-viewed"))
 }
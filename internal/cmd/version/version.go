@@ -2,23 +2,64 @@
 package version
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
+	"github.com/jorres/jira-tui/internal/cmdutil"
 	v "github.com/jorres/jira-tui/internal/version"
 )
 
+const checkTimeout = 5 * time.Second
+
 // NewCmdVersion is a version command.
 func NewCmdVersion() *cobra.Command {
-	return &cobra.Command{
+	cmd := cobra.Command{
 		Use:   "version",
 		Short: "Print the app version information",
-		Long:  "Print the app version and build information",
-		Run:   version,
+		Long: `Print the app version and build information.
+
+With --check it also tells if a newer release is available on GitHub. Set
+update_check to false in the config to never make this network call.`,
+		Example: `$ jira version
+$ jira version --check`,
+		Run: version,
 	}
+
+	cmd.Flags().Bool("check", false, "Check if a newer version is available")
+
+	return &cmd
 }
 
-func version(*cobra.Command, []string) {
+func version(cmd *cobra.Command, _ []string) {
 	fmt.Println(v.Info())
+
+	check, err := cmd.Flags().GetBool("check")
+	cmdutil.ExitIfError(err)
+	if !check {
+		return
+	}
+
+	if viper.IsSet("update_check") && !viper.GetBool("update_check") {
+		cmdutil.Warn("Update check is disabled with update_check in the config")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+
+	latest, err := v.Latest(ctx, v.LatestReleaseURL)
+	if err != nil {
+		cmdutil.Warn("Unable to check for updates: %s", err)
+		return
+	}
+
+	if v.IsNewer(latest, v.Version) {
+		cmdutil.Warn("A newer version %s is available: %s", latest, v.ReleasesPage)
+		return
+	}
+	cmdutil.Success("You are on the latest version")
 }
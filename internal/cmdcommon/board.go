@@ -0,0 +1,52 @@
+package cmdcommon
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/jorres/jira-tui/api"
+	"github.com/jorres/jira-tui/internal/cmdutil"
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// SetBoardFlag adds the --board flag used by the commands moving issues
+// between the backlog and the board.
+func SetBoardFlag(cmd *cobra.Command) {
+	cmd.Flags().Int("board", 0, "ID of the board, defaults to board.id from the config")
+}
+
+// MoveIssues moves the issues passed as args with move, to the destination
+// of the board from --board or the config.
+func MoveIssues(cmd *cobra.Command, args []string, destination string, move func(c *jira.Client, boardID, key string) error) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	boardID, err := cmd.Flags().GetInt("board")
+	cmdutil.ExitIfError(err)
+
+	if boardID == 0 {
+		boardID = viper.GetInt("board.id")
+	}
+	if boardID == 0 {
+		cmdutil.Failed("No board ID configured, set board.id in the config or pass --board")
+	}
+
+	client := api.DefaultClient(debug)
+	project := viper.GetString("project.key")
+
+	for _, arg := range args {
+		key := cmdutil.GetJiraIssueKey(project, arg)
+
+		err := func() error {
+			s := cmdutil.Info(fmt.Sprintf("Moving %s to the %s...", key, destination))
+			defer s.Stop()
+
+			return move(client, fmt.Sprintf("%d", boardID), key)
+		}()
+		cmdutil.ExitIfError(err)
+
+		cmdutil.Success("Issue %q moved to the %s", key, destination)
+	}
+}
@@ -0,0 +1,43 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jorres/jira-tui/pkg/jira"
+)
+
+// CustomFieldKeys returns the keys of the configured custom fields that are
+// given a value, custom fields are passed by their dashed name.
+func CustomFieldKeys(fields map[string]string, configuredFields []jira.IssueTypeField) []string {
+	keys := make([]string, 0, len(fields))
+	for _, configured := range configuredFields {
+		identifier := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(configured.Name)), " ", "-")
+		for key, val := range fields {
+			if strings.ToLower(key) == identifier && val != "" {
+				keys = append(keys, configured.Key)
+			}
+		}
+	}
+	return keys
+}
+
+// MissingRequiredFields returns the names of the required fields, keyed by the
+// field key, that are not set. The names are sorted.
+func MissingRequiredFields(required map[string]string, set func(key string) bool) []string {
+	missing := make([]string, 0)
+	for key, name := range required {
+		if !set(key) {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// MissingRequiredFieldsError reports the required fields left empty when prompts
+// are disabled.
+func MissingRequiredFieldsError(missing []string) error {
+	return fmt.Errorf("required fields are missing: %s", strings.Join(missing, ", "))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"runtime"
 	"strconv"
 	"strings"
 	"time"
@@ -62,17 +63,17 @@ func Info(msg string) *spinner.Spinner {
 
 // Success prints success message in stdout.
 func Success(msg string, args ...interface{}) {
-	_, _ = fmt.Fprintf(os.Stdout, fmt.Sprintf("\n\u001B[0;32m✓\u001B[0m %s\n", msg), args...)
+	_, _ = fmt.Fprintf(os.Stdout, fmt.Sprintf("\n%s %s\n", colorize("0;32", "✓"), msg), args...)
 }
 
 // Warn prints warning message in stderr.
 func Warn(msg string, args ...interface{}) {
-	_, _ = fmt.Fprintf(os.Stderr, fmt.Sprintf("\u001B[0;33m%s\u001B[0m\n", msg), args...)
+	_, _ = fmt.Fprintf(os.Stderr, fmt.Sprintf("%s\n", colorize("0;33", msg)), args...)
 }
 
 // Fail prints failure message in stderr.
 func Fail(msg string, args ...interface{}) {
-	_, _ = fmt.Fprintf(os.Stderr, fmt.Sprintf("\u001B[0;31m✗\u001B[0m %s\n", msg), args...)
+	_, _ = fmt.Fprintf(os.Stderr, fmt.Sprintf("%s %s\n", colorize("0;31", "✗"), msg), args...)
 }
 
 // Failed prints failure message in stderr and exits.
@@ -87,6 +88,55 @@ func Navigate(server, path string) error {
 	return browser.Browse(url)
 }
 
+// BrowserAvailable tells if a browser can be opened for the user: either $BROWSER
+// is set, or this is a local session with a graphical environment.
+func BrowserAvailable() bool {
+	if os.Getenv("BROWSER") != "" {
+		return true
+	}
+	if os.Getenv("SSH_CONNECTION") != "" || os.Getenv("SSH_TTY") != "" {
+		return false
+	}
+	switch runtime.GOOS {
+	case "darwin", "windows":
+		return true
+	default:
+		return os.Getenv("DISPLAY") != "" || os.Getenv("WAYLAND_DISPLAY") != ""
+	}
+}
+
+// HyperlinksSupported tells if the terminal is known to render OSC 8 hyperlinks.
+// There is no way to query it, so this goes by the environment and stays off
+// for anything unrecognised, as well as inside tmux which may drop them.
+func HyperlinksSupported() bool {
+	if os.Getenv("TMUX") != "" {
+		return false
+	}
+	if os.Getenv("WT_SESSION") != "" || os.Getenv("KONSOLE_VERSION") != "" {
+		return true
+	}
+	switch os.Getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "vscode", "ghostty", "Hyper", "Tabby":
+		return true
+	}
+	term := os.Getenv("TERM")
+	for _, name := range []string{"kitty", "alacritty", "foot", "wezterm", "ghostty"} {
+		if strings.Contains(term, name) {
+			return true
+		}
+	}
+	// VTE based terminals (GNOME Terminal, Tilix, ...) support them since 0.50.
+	if vte, err := strconv.Atoi(os.Getenv("VTE_VERSION")); err == nil && vte >= 5000 {
+		return true
+	}
+	return false
+}
+
+// Hyperlink wraps text in an OSC 8 escape sequence linking it to url.
+func Hyperlink(url, text string) string {
+	return "\x1b]8;;" + url + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+}
+
 // GenerateServerBrowseURL will return the `browse` URL for a given key.
 // The server section can be overridden via `browse_server` in config.
 // This is useful if your API endpoint is separate from the web client endpoint.
@@ -207,3 +257,57 @@ func GetTUIStyleConfig() tui.TableStyle {
 		SelectionTextIsBold: bold,
 	}
 }
+
+// IsDoneStatus tells if the issue status marks completed work. Status names listed
+// in `ui.done_statuses` take precedence, otherwise the status category decides.
+func IsDoneStatus(status jira.IssueStatus) bool {
+	if done := viper.GetStringSlice("ui.done_statuses"); len(done) > 0 {
+		for _, name := range done {
+			if strings.EqualFold(name, status.Name) {
+				return true
+			}
+		}
+		return false
+	}
+	if status.StatusCategory.Key != "" {
+		return status.StatusCategory.Key == jira.StatusCategoryDone
+	}
+	return status.Name == "Done"
+}
+
+// IssueTypeIcon returns the icon of the issue type configured in
+// `ui.theme.issue_type_icons`, the match is case-insensitive. Unmapped types get
+// the default icon and ok is false.
+func IssueTypeIcon(issueType string) (icon string, ok bool) {
+	// viper lowercases map keys
+	icons := viper.GetStringMapString("ui.theme.issue_type_icons")
+	if icon, ok := icons[strings.ToLower(issueType)]; ok && icon != "" {
+		return icon, true
+	}
+	if issueType == "Bug" {
+		return "🐞", false
+	}
+	return "⭐", false
+}
+
+// ColorEnabled tells if output may be colored. Colors are turned off with the
+// `--no-color` flag or by setting the NO_COLOR environment variable.
+func ColorEnabled() bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	return !viper.GetBool("no_color")
+}
+
+func colorize(code, msg string) string {
+	if !ColorEnabled() {
+		return msg
+	}
+	return fmt.Sprintf("\u001B[%sm%s\u001B[0m", code, msg)
+}
+
+// CommentsOldestFirst tells if comments are listed in chronological order, as
+// configured with `ui.issue.comment_order: asc`. Newest first is the default.
+func CommentsOldestFirst() bool {
+	return strings.EqualFold(viper.GetString("ui.issue.comment_order"), "asc")
+}
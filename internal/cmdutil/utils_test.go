@@ -2,10 +2,12 @@ package cmdutil
 
 import (
 	"os"
+	"runtime"
 	"testing"
 	"time"
 
 	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/jorres/jira-tui/pkg/jira"
@@ -264,3 +266,107 @@ func TestGetSubtaskHandle(t *testing.T) {
 		})
 	}
 }
+
+func TestIsDoneStatus(t *testing.T) {
+	status := func(name, category string) jira.IssueStatus {
+		s := jira.IssueStatus{Name: name}
+		s.StatusCategory.Key = category
+		return s
+	}
+
+	assert.True(t, IsDoneStatus(status("Closed", "done")))
+	assert.False(t, IsDoneStatus(status("Done", "indeterminate")))
+	assert.True(t, IsDoneStatus(status("Done", "")))
+	assert.False(t, IsDoneStatus(status("Resolved", "")))
+
+	viper.Set("ui.done_statuses", []string{"resolved", "Erledigt"})
+	defer viper.Set("ui.done_statuses", nil)
+
+	assert.True(t, IsDoneStatus(status("Resolved", "")))
+	assert.True(t, IsDoneStatus(status("Erledigt", "indeterminate")))
+	assert.False(t, IsDoneStatus(status("Closed", "done")))
+}
+
+func TestColorEnabled(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	assert.True(t, ColorEnabled())
+
+	viper.Set("no_color", true)
+	assert.False(t, ColorEnabled())
+	viper.Set("no_color", false)
+
+	t.Setenv("NO_COLOR", "1")
+	assert.False(t, ColorEnabled())
+}
+
+func TestIssueTypeIcon(t *testing.T) {
+	icon, ok := IssueTypeIcon("Bug")
+	assert.Equal(t, "🐞", icon)
+	assert.False(t, ok)
+
+	icon, ok = IssueTypeIcon("Spike")
+	assert.Equal(t, "⭐", icon)
+	assert.False(t, ok)
+
+	viper.Set("ui.theme.issue_type_icons", map[string]string{"spike": "🔬", "bug": "🪲"})
+	defer viper.Set("ui.theme.issue_type_icons", nil)
+
+	icon, ok = IssueTypeIcon("Spike")
+	assert.Equal(t, "🔬", icon)
+	assert.True(t, ok)
+
+	icon, _ = IssueTypeIcon("Bug")
+	assert.Equal(t, "🪲", icon)
+
+	icon, ok = IssueTypeIcon("Story")
+	assert.Equal(t, "⭐", icon)
+	assert.False(t, ok)
+}
+
+func TestBrowserAvailable(t *testing.T) {
+	for _, env := range []string{"BROWSER", "SSH_CONNECTION", "SSH_TTY", "DISPLAY", "WAYLAND_DISPLAY"} {
+		t.Setenv(env, "")
+	}
+
+	t.Setenv("SSH_CONNECTION", "10.0.0.1 51234 10.0.0.2 22")
+	t.Setenv("DISPLAY", ":0")
+	assert.False(t, BrowserAvailable())
+
+	t.Setenv("BROWSER", "w3m")
+	assert.True(t, BrowserAvailable())
+
+	if runtime.GOOS == "linux" {
+		t.Setenv("BROWSER", "")
+		t.Setenv("SSH_CONNECTION", "")
+		assert.True(t, BrowserAvailable())
+
+		t.Setenv("DISPLAY", "")
+		assert.False(t, BrowserAvailable())
+	}
+}
+
+func TestHyperlinksSupported(t *testing.T) {
+	for _, env := range []string{"TMUX", "WT_SESSION", "KONSOLE_VERSION", "TERM_PROGRAM", "TERM", "VTE_VERSION"} {
+		t.Setenv(env, "")
+	}
+	t.Setenv("TERM", "xterm-256color")
+	assert.False(t, HyperlinksSupported())
+
+	t.Setenv("VTE_VERSION", "4803")
+	assert.False(t, HyperlinksSupported())
+
+	t.Setenv("VTE_VERSION", "7600")
+	assert.True(t, HyperlinksSupported())
+
+	t.Setenv("VTE_VERSION", "")
+	t.Setenv("TERM", "xterm-kitty")
+	assert.True(t, HyperlinksSupported())
+
+	t.Setenv("TMUX", "/tmp/tmux-1000/default,1234,0")
+	assert.False(t, HyperlinksSupported())
+}
+
+func TestHyperlink(t *testing.T) {
+	assert.Equal(t, "\x1b]8;;https://jira.example.com/browse/TEST-1\x1b\\TEST-1\x1b]8;;\x1b\\",
+		Hyperlink("https://jira.example.com/browse/TEST-1", "TEST-1"))
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const queriesKey = "queries"
+
+var queryName = regexp.MustCompile(`^[\w-]+$`)
+
+// SavedQuery is a named JQL query stored in the `queries` section of the config.
+type SavedQuery struct {
+	Name string
+	JQL  string
+}
+
+// Title implements list.Item.
+func (q SavedQuery) Title() string { return q.Name }
+
+// Description implements list.Item.
+func (q SavedQuery) Description() string { return q.JQL }
+
+// FilterValue implements list.Item.
+func (q SavedQuery) FilterValue() string { return q.Name }
+
+// SavedQueries returns the saved queries sorted by name.
+func SavedQueries() []SavedQuery {
+	stored := viper.GetStringMapString(queriesKey)
+
+	queries := make([]SavedQuery, 0, len(stored))
+	for name, jql := range stored {
+		queries = append(queries, SavedQuery{Name: name, JQL: jql})
+	}
+	sort.Slice(queries, func(i, j int) bool { return queries[i].Name < queries[j].Name })
+
+	return queries
+}
+
+// GetSavedQuery looks a saved query up by name, names are case-insensitive.
+func GetSavedQuery(name string) (SavedQuery, bool) {
+	for _, q := range SavedQueries() {
+		if q.Name == normalizeQueryName(name) {
+			return q, true
+		}
+	}
+	return SavedQuery{}, false
+}
+
+// SaveQuery stores the query in the config file, replacing the one of the same name.
+func SaveQuery(name, jql string) error {
+	if !queryName.MatchString(name) {
+		return fmt.Errorf("invalid query name %q, use letters, digits, '-' and '_' only", name)
+	}
+
+	// Setting the nested key would shadow the rest of the saved queries.
+	queries := viper.GetStringMapString(queriesKey)
+	queries[normalizeQueryName(name)] = jql
+	return Set(queriesKey, queries)
+}
+
+// viper keys are case-insensitive, the names are stored lowercased.
+func normalizeQueryName(name string) string {
+	return strings.ToLower(name)
+}
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveQuery(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "config.yml")
+	assert.NoError(t, os.WriteFile(file, []byte("server: https://jira.example.com\nqueries:\n  mine: assignee = currentUser()\n"), 0o600))
+
+	viper.Reset()
+	defer viper.Reset()
+	viper.SetConfigFile(file)
+	assert.NoError(t, viper.ReadInConfig())
+	viper.Set("debug", true)
+
+	assert.NoError(t, SaveQuery("Review", "status = 'In Review'"))
+	assert.Error(t, SaveQuery("in review", "status = 'In Review'"))
+
+	assert.Equal(t, []SavedQuery{
+		{Name: "mine", JQL: "assignee = currentUser()"},
+		{Name: "review", JQL: "status = 'In Review'"},
+	}, SavedQueries())
+
+	q, ok := GetSavedQuery("REVIEW")
+	assert.True(t, ok)
+	assert.Equal(t, "status = 'In Review'", q.JQL)
+
+	// only the file contents are written back
+	b, err := os.ReadFile(file)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), "review: status = 'In Review'")
+	assert.Contains(t, string(b), "server: https://jira.example.com")
+	assert.NotContains(t, string(b), "debug")
+}
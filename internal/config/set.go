@@ -0,0 +1,29 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+)
+
+// Set stores the value in the config file and in the running config. Only the
+// file contents are written back, not the values coming from flags or env.
+func Set(key string, value any) error {
+	file := viper.ConfigFileUsed()
+	if !Exists(file) {
+		return fmt.Errorf("missing configuration file, run 'jira init' to configure the tool")
+	}
+
+	v := viper.New()
+	v.SetConfigFile(file)
+	if err := v.ReadInConfig(); err != nil {
+		return err
+	}
+	v.Set(key, value)
+	if err := v.WriteConfig(); err != nil {
+		return err
+	}
+
+	viper.Set(key, value)
+	return nil
+}
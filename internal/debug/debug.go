@@ -7,19 +7,37 @@ import (
 	"path/filepath"
 )
 
-func Debug(v ...any) {
+// MaxSize is the size the debug log grows to before it is rotated, the
+// previous log is kept next to it with a .1 suffix.
+const MaxSize = 5 << 20
+
+// Path returns the location of the debug log.
+func Path() (string, error) {
 	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".jira-tui", "debug.log"), nil
+}
+
+// RotatedPath returns the location of the previous debug log.
+func RotatedPath(path string) string {
+	return path + ".1"
+}
+
+func Debug(v ...any) {
+	logPath, err := Path()
 	if err != nil {
 		return
 	}
 
-	dir := filepath.Join(home, ".jira-tui")
-	err = os.MkdirAll(dir, 0o755)
+	err = os.MkdirAll(filepath.Dir(logPath), 0o755)
 	if err != nil {
 		return
 	}
 
-	logPath := filepath.Join(dir, "debug.log")
+	rotate(logPath, MaxSize)
+
 	f, err := os.OpenFile(logPath,
 		os.O_CREATE|os.O_WRONLY|os.O_APPEND,
 		0o644,
@@ -37,6 +55,15 @@ func Debug(v ...any) {
 	fmt.Fprintln(f)
 }
 
+// rotate moves the log aside once it reaches maxSize, replacing the previously rotated one.
+func rotate(path string, maxSize int64) {
+	info, err := os.Stat(path)
+	if err != nil || info.Size() < maxSize {
+		return
+	}
+	_ = os.Rename(path, RotatedPath(path))
+}
+
 func Fatal(v ...any) {
 	Debug(v...)
 	log.Fatal("Exiting in debug.Fatal()...")
@@ -0,0 +1,29 @@
+package debug
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotate(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "debug.log")
+
+	rotate(file, 10)
+	assert.NoFileExists(t, RotatedPath(file))
+
+	assert.NoError(t, os.WriteFile(file, []byte("short\n"), 0o644))
+	rotate(file, 10)
+	assert.FileExists(t, file)
+	assert.NoFileExists(t, RotatedPath(file))
+
+	assert.NoError(t, os.WriteFile(file, []byte("long enough\n"), 0o644))
+	rotate(file, 10)
+	assert.NoFileExists(t, file)
+
+	data, err := os.ReadFile(RotatedPath(file))
+	assert.NoError(t, err)
+	assert.Equal(t, "long enough\n", string(data))
+}
@@ -0,0 +1,195 @@
+package editing
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jorres/md2adf-translator/adf"
+	"github.com/jorres/md2adf-translator/adf2md"
+	"github.com/jorres/md2adf-translator/md2adf"
+)
+
+// Panels and expands are written as fenced blocks, the panel type or the
+// expand title follows the opening fence:
+//
+//	:::warning
+//	Deploys are frozen until Monday.
+//	:::
+//
+//	:::expand Stack trace
+//	...
+//	:::
+const blockFence = ":::"
+
+const (
+	nodeExpand       = adf.NodeType("expand")
+	nodeNestedExpand = adf.NodeType("nestedExpand")
+
+	blockExpand = "expand"
+)
+
+var blockStart = regexp.MustCompile(`^:::\s*(info|note|warning|error|success|expand)(?:\s+(.*))?$`)
+
+// TranslateToADF translates markdown into an ADF document. On top of what the
+// translator understands, it converts ::: panel and expand blocks.
+func TranslateToADF(translator *md2adf.Translator, body string) (*adf.ADFDocument, error) {
+	content, err := translateBlocks(translator, strings.Split(body, "\n"), false)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := adf.NewADFDocument()
+	doc.Content = append(doc.Content, content...)
+	return doc, nil
+}
+
+func translateBlocks(translator *md2adf.Translator, lines []string, nested bool) ([]*adf.ADFNode, error) {
+	var (
+		out   []*adf.ADFNode
+		chunk []string
+	)
+
+	flush := func() error {
+		md := strings.Join(chunk, "\n")
+		chunk = nil
+		if strings.TrimSpace(md) == "" {
+			return nil
+		}
+		doc, err := translator.TranslateToADF([]byte(md))
+		if err != nil {
+			return err
+		}
+		out = append(out, doc.Content...)
+		return nil
+	}
+
+	inCode := false
+	for n := 0; n < len(lines); n++ {
+		line := strings.TrimSpace(lines[n])
+		if strings.HasPrefix(line, "```") {
+			inCode = !inCode
+		}
+
+		m := blockStart.FindStringSubmatch(line)
+		if inCode || m == nil {
+			chunk = append(chunk, lines[n])
+			continue
+		}
+
+		end := blockEnd(lines, n+1)
+		if end < 0 {
+			// unclosed blocks are kept as text
+			chunk = append(chunk, lines[n])
+			continue
+		}
+
+		if err := flush(); err != nil {
+			return nil, err
+		}
+		inner, err := translateBlocks(translator, lines[n+1:end], nested || m[1] == blockExpand)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, blockNode(m[1], strings.TrimSpace(m[2]), inner, nested))
+
+		n = end
+	}
+
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// blockEnd returns the index of the fence closing the block opened right before
+// lines[from], or -1 if the block is never closed.
+func blockEnd(lines []string, from int) int {
+	depth, inCode := 1, false
+	for n := from; n < len(lines); n++ {
+		line := strings.TrimSpace(lines[n])
+		if strings.HasPrefix(line, "```") {
+			inCode = !inCode
+		}
+		if inCode {
+			continue
+		}
+
+		switch {
+		case blockStart.MatchString(line):
+			depth++
+		case line == blockFence:
+			depth--
+			if depth == 0 {
+				return n
+			}
+		}
+	}
+	return -1
+}
+
+func blockNode(kind, title string, content []*adf.ADFNode, nested bool) *adf.ADFNode {
+	if content == nil {
+		content = []*adf.ADFNode{}
+	}
+
+	if kind != blockExpand {
+		panel := adf.NewPanelNode(kind)
+		panel.Content = content
+		return panel
+	}
+
+	// an expand inside of another block has to be a nested one
+	typ := nodeExpand
+	if nested {
+		typ = nodeNestedExpand
+	}
+	return &adf.ADFNode{
+		Type:    typ,
+		Attrs:   map[string]any{"title": title},
+		Content: content,
+	}
+}
+
+// BlockOpenHooks render panels and expands as ::: blocks when translating ADF
+// back to markdown, so they survive an edit. Use with BlockCloseHooks.
+func BlockOpenHooks() map[adf.NodeType]func(adf2md.Connector) string {
+	return map[adf.NodeType]func(adf2md.Connector) string{
+		adf.NodePanel: func(n adf2md.Connector) string {
+			panelType := "info"
+			if t, ok := blockAttr(n, "panelType"); ok {
+				panelType = t
+			}
+			return fmt.Sprintf("\n%s%s\n\n", blockFence, panelType)
+		},
+		nodeExpand:       expandOpenHook,
+		nodeNestedExpand: expandOpenHook,
+	}
+}
+
+// BlockCloseHooks close the blocks opened by BlockOpenHooks.
+func BlockCloseHooks() map[adf.NodeType]func(adf2md.Connector) string {
+	closeHook := func(adf2md.Connector) string { return fmt.Sprintf("\n%s\n\n", blockFence) }
+
+	return map[adf.NodeType]func(adf2md.Connector) string{
+		adf.NodePanel:    closeHook,
+		nodeExpand:       closeHook,
+		nodeNestedExpand: closeHook,
+	}
+}
+
+func expandOpenHook(n adf2md.Connector) string {
+	if title, ok := blockAttr(n, "title"); ok && title != "" {
+		return fmt.Sprintf("\n%s%s %s\n\n", blockFence, blockExpand, title)
+	}
+	return fmt.Sprintf("\n%s%s\n\n", blockFence, blockExpand)
+}
+
+func blockAttr(n adf2md.Connector, key string) (string, bool) {
+	attrs, ok := n.GetAttributes().(map[string]any)
+	if !ok {
+		return "", false
+	}
+	v, ok := attrs[key].(string)
+	return v, ok
+}
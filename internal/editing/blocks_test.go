@@ -0,0 +1,61 @@
+package editing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jorres/md2adf-translator/adf"
+	"github.com/jorres/md2adf-translator/adf2md"
+	"github.com/jorres/md2adf-translator/md2adf"
+)
+
+func TestTranslateToADFBlocks(t *testing.T) {
+	body := "Intro\n\n" +
+		":::warning\nDeploys are frozen.\n:::\n\n" +
+		":::expand Stack trace\nDetails\n\n:::note\nInner\n:::\n:::\n\n" +
+		"```\n:::info\n```\n\n" +
+		":::error\nnever closed"
+
+	doc, err := TranslateToADF(md2adf.NewTranslator(), body)
+	assert.NoError(t, err)
+
+	types := make([]adf.NodeType, 0, len(doc.Content))
+	for _, n := range doc.Content {
+		types = append(types, n.Type)
+	}
+	assert.Equal(t, []adf.NodeType{
+		adf.NodeParagraph, adf.NodePanel, nodeExpand, adf.NodeCodeBlock, adf.NodeParagraph,
+	}, types)
+
+	panel := doc.Content[1]
+	assert.Equal(t, "warning", panel.Attrs["panelType"])
+	assert.Equal(t, adf.NodeParagraph, panel.Content[0].Type)
+
+	expand := doc.Content[2]
+	assert.Equal(t, "Stack trace", expand.Attrs["title"])
+	assert.Len(t, expand.Content, 2)
+	assert.Equal(t, adf.NodePanel, expand.Content[1].Type)
+	assert.Equal(t, "note", expand.Content[1].Attrs["panelType"])
+}
+
+func TestBlocksRoundTrip(t *testing.T) {
+	body := ":::success\nShipped\n:::\n\n:::expand Logs\nAll good\n:::"
+
+	doc, err := TranslateToADF(md2adf.NewTranslator(), body)
+	assert.NoError(t, err)
+
+	out := adf2md.NewTranslator(adf2md.NewMarkdownTranslator(
+		adf2md.WithMarkdownOpenHooks(BlockOpenHooks()),
+		adf2md.WithMarkdownCloseHooks(BlockCloseHooks()),
+	)).Translate(&adf.ADFNode{Type: "doc", Content: doc.Content})
+
+	assert.Contains(t, out, ":::success\n")
+	assert.Contains(t, out, ":::expand Logs\n")
+	assert.Equal(t, 2, strings.Count(out, "\n:::\n"))
+
+	again, err := TranslateToADF(md2adf.NewTranslator(), out)
+	assert.NoError(t, err)
+	assert.Equal(t, doc.Content, again.Content)
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/jorres/jira-tui/api"
 	"github.com/jorres/jira-tui/pkg/jira"
@@ -89,3 +90,42 @@ func ResolveUserIDToEmail(userID string, client *jira.Client, project string) st
 
 	return ""
 }
+
+// displayNames caches resolved display names for the session, failed
+// lookups are cached as well so that they aren't retried on every render.
+var displayNames = struct {
+	sync.Mutex
+	byID map[string]string
+}{byID: make(map[string]string)}
+
+// CachedDisplayName returns the display name of the user ID if it was already
+// resolved, it never hits the API.
+func CachedDisplayName(userID string) (string, bool) {
+	displayNames.Lock()
+	defer displayNames.Unlock()
+
+	name, ok := displayNames.byID[userID]
+	return name, ok
+}
+
+// ResolveUserIDToDisplayName resolves a Jira user ID to the user display name.
+// It returns an empty string if the user can't be found.
+func ResolveUserIDToDisplayName(userID string, client *jira.Client) string {
+	if name, ok := CachedDisplayName(userID); ok {
+		return name
+	}
+
+	var name string
+	user, err := api.ProxyUserGet(client, &jira.UserGetOptions{
+		AccountID: userID,
+	})
+	if err == nil {
+		name = user.DisplayName
+	}
+
+	displayNames.Lock()
+	displayNames.byID[userID] = name
+	displayNames.Unlock()
+
+	return name
+}
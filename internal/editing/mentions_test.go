@@ -0,0 +1,18 @@
+package editing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveUserIDToDisplayNameCached(t *testing.T) {
+	displayNames.Lock()
+	displayNames.byID["557058:abc"] = "John Doe"
+	displayNames.byID["557058:gone"] = ""
+	displayNames.Unlock()
+
+	// Cached names, including failed lookups, don't hit the API.
+	assert.Equal(t, "John Doe", ResolveUserIDToDisplayName("557058:abc", nil))
+	assert.Equal(t, "", ResolveUserIDToDisplayName("557058:gone", nil))
+}
@@ -8,6 +8,23 @@ import (
 	"github.com/jorres/md2adf-translator/md2adf"
 )
 
+// NewAdf2MdTranslator returns the translator preparing an issue for the editor.
+// Panels are closed on a line of their own, so the paragraph after a panel
+// doesn't end up inside of it once the markdown is sent back.
+func NewAdf2MdTranslator(emailResolver adf2md.UserEmailResolver) *adf2md.Translator {
+	return adf2md.NewTranslator(adf2md.NewJiraMarkdownTranslator(
+		adf2md.WithUserEmailResolver(emailResolver),
+		adf2md.WithMarkdownOpenHooks(BlockOpenHooks()),
+		adf2md.WithMarkdownCloseHooks(BlockCloseHooks()),
+	))
+}
+
+// CommentSeparator is the line put before each comment when the description
+// and the comments of an issue are shown as a single document.
+func CommentSeparator(author, at string) string {
+	return fmt.Sprintf("# DO NOT EDIT THIS LINE - Comment by %s (at %s)", author, at)
+}
+
 func PrepareMD2AdfTranslator(body string, client *jira.Client, issueKey string, reverseTranslator *adf2md.Translator) (*md2adf.Translator, error) {
 	var userMapping map[string]string
 
@@ -37,7 +54,7 @@ func PrepareMD2AdfTranslator(body string, client *jira.Client, issueKey string,
 
 // ConvertMarkdownToADF converts markdown to ADF JSON string if mentions are found
 func ConvertMarkdownToADF(body string, translator *md2adf.Translator) (string, error) {
-	adfDoc, err := translator.TranslateToADF([]byte(body))
+	adfDoc, err := TranslateToADF(translator, body)
 	if err != nil {
 		return "", err
 	}
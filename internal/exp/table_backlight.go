@@ -46,19 +46,35 @@ func fetchBacklogIssueKeys(client *jira.Client, boardID string, queryParams *que
 	return issueKeys, nil
 }
 
-func CreateBoardStateResolver(client *jira.Client, boardID int, queryParams *query.IssueParams) *BoardStateResolver {
+// ErrBoardUnavailable is returned when the backlog of the configured board cannot be fetched.
+type ErrBoardUnavailable struct {
+	BoardID int
+	Err     error
+}
+
+func (e *ErrBoardUnavailable) Error() string {
+	return fmt.Sprintf("board %d not found or inaccessible — backlog highlighting disabled", e.BoardID)
+}
+
+func (e *ErrBoardUnavailable) Unwrap() error {
+	return e.Err
+}
+
+// CreateBoardStateResolver fetches the backlog of the board, it returns nil if
+// the tab has no board. A failed fetch is reported with ErrBoardUnavailable.
+func CreateBoardStateResolver(client *jira.Client, boardID int, queryParams *query.IssueParams) (*BoardStateResolver, error) {
 	if boardID == 0 {
-		return nil
+		return nil, nil
 	}
 
 	debug.Debug("Tab has board ID %d, fetching backlog issues", boardID)
 	backlogIssueKeys, fetchErr := fetchBacklogIssueKeys(client, fmt.Sprintf("%d", boardID), queryParams)
 	if fetchErr != nil {
 		debug.Debug("Failed to fetch backlog issues: %v", fetchErr)
-		return nil
+		return nil, &ErrBoardUnavailable{BoardID: boardID, Err: fetchErr}
 	}
 
-	return &BoardStateResolver{backlogIssueKeys: backlogIssueKeys}
+	return &BoardStateResolver{backlogIssueKeys: backlogIssueKeys}, nil
 }
 
 type BacklogState int
@@ -0,0 +1,129 @@
+// Package history keeps track of the issues recently opened by the user and
+// of when each of them was last opened.
+package history
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// MaxEntries is the number of issue keys kept in the history.
+const MaxEntries = 30
+
+// MaxViewed is the number of issues whose last viewed time is remembered,
+// the history is made of the most recent of them.
+const MaxViewed = 500
+
+const fileName = "history"
+
+func filePath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".jira-tui", fileName), nil
+}
+
+// Load returns the recently opened issue keys, newest first.
+func Load() ([]string, error) {
+	keys, _, err := load()
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) > MaxEntries {
+		keys = keys[:MaxEntries]
+	}
+	return keys, nil
+}
+
+// Viewed returns when each remembered issue was last opened, the issues
+// opened too long ago or before the times were recorded are left out.
+func Viewed() (map[string]time.Time, error) {
+	_, viewed, err := load()
+	return viewed, err
+}
+
+// load reads the history file, one "KEY<TAB>RFC3339 time" line per issue,
+// newest first. Lines written before times were recorded only have the key.
+func load() ([]string, map[string]time.Time, error) {
+	path, err := filePath()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := make([]string, 0, MaxEntries)
+	viewed := make(map[string]time.Time)
+
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return keys, viewed, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, at, _ := strings.Cut(strings.TrimSpace(scanner.Text()), "\t")
+		if key == "" {
+			continue
+		}
+		keys = append(keys, key)
+		if t, err := time.Parse(time.RFC3339, at); err == nil {
+			viewed[key] = t
+		}
+	}
+
+	return keys, viewed, scanner.Err()
+}
+
+// Add records the issue key as the most recently opened one, opened now.
+func Add(key string) error {
+	keys, viewed, err := load()
+	if err != nil {
+		return err
+	}
+
+	path, err := filePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	keys = push(keys, key, MaxViewed)
+	viewed[key] = time.Now()
+
+	var out strings.Builder
+	for _, k := range keys {
+		out.WriteString(k)
+		if t, ok := viewed[k]; ok {
+			out.WriteString("\t" + t.Format(time.RFC3339))
+		}
+		out.WriteString("\n")
+	}
+
+	return os.WriteFile(path, []byte(out.String()), 0o644)
+}
+
+// push puts key in front of keys, removing its older occurrence
+// and dropping the oldest keys over the limit.
+func push(keys []string, key string, limit int) []string {
+	out := make([]string, 0, len(keys)+1)
+	out = append(out, key)
+	for _, k := range keys {
+		if k != key {
+			out = append(out, k)
+		}
+	}
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
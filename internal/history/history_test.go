@@ -0,0 +1,63 @@
+package history
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPush(t *testing.T) {
+	assert.Equal(t, []string{"A-1"}, push([]string{}, "A-1", 3))
+	assert.Equal(t, []string{"A-2", "A-1"}, push([]string{"A-1"}, "A-2", 3))
+	assert.Equal(t, []string{"A-1", "A-3", "A-2"}, push([]string{"A-3", "A-2", "A-1"}, "A-1", 3))
+	assert.Equal(t, []string{"A-4", "A-3", "A-2"}, push([]string{"A-3", "A-2", "A-1"}, "A-4", 3))
+}
+
+func TestAddAndLoad(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	keys, err := Load()
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+
+	assert.NoError(t, Add("A-1"))
+	assert.NoError(t, Add("A-2"))
+	assert.NoError(t, Add("A-1"))
+
+	keys, err = Load()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"A-1", "A-2"}, keys)
+}
+
+func TestLastViewed(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	viewed, err := Viewed()
+	assert.NoError(t, err)
+	assert.Empty(t, viewed)
+
+	// Histories written before the times were recorded only have keys
+	path, err := filePath()
+	assert.NoError(t, err)
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	assert.NoError(t, os.WriteFile(path, []byte("A-1\nA-2\n"), 0o644))
+
+	viewed, err = Viewed()
+	assert.NoError(t, err)
+	assert.Empty(t, viewed)
+
+	before := time.Now().Add(-time.Second)
+	assert.NoError(t, Add("A-2"))
+
+	viewed, err = Viewed()
+	assert.NoError(t, err)
+	assert.Len(t, viewed, 1)
+	assert.True(t, viewed["A-2"].After(before))
+
+	keys, err := Load()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"A-2", "A-1"}, keys)
+}
@@ -2,6 +2,7 @@ package query
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -59,8 +60,26 @@ func (i *Issue) SetParams(newParams *IssueParams) {
 	i.params = newParams
 }
 
+// OrderByRank orders issues the way they are ranked on the board.
+const OrderByRank = "rank"
+
 // Get returns constructed jql query.
 func (i *Issue) Get() string {
+	return i.get(true)
+}
+
+// GetForBoard returns the query for the agile endpoints. These list issues in
+// board rank on their own, so a rank ordering is left out to keep the server order.
+func (i *Issue) GetForBoard() string {
+	return i.get(!i.OrdersByRank())
+}
+
+// OrdersByRank tells if the issues are listed in board rank.
+func (i *Issue) OrdersByRank() bool {
+	return !i.params.Latest && strings.EqualFold(i.params.OrderBy, OrderByRank)
+}
+
+func (i *Issue) get(ordered bool) string {
 	var q *jql.JQL
 
 	defer func() {
@@ -71,8 +90,8 @@ func (i *Issue) Get() string {
 
 	q, obf := jql.NewJQL(i.params.Project), i.params.OrderBy
 	if obf == "created" &&
-		(i.params.Updated != "" || i.params.UpdatedBefore != "" || i.params.UpdatedAfter != "") &&
-		(i.params.Created == "" && i.params.CreatedBefore == "" && i.params.CreatedAfter == "") {
+		(i.params.Updated != "" || i.params.UpdatedBefore != "" || i.params.UpdatedAfter != "" || i.params.UpdatedSince != "") &&
+		(i.params.Created == "" && i.params.CreatedBefore == "" && i.params.CreatedAfter == "" && i.params.CreatedSince == "") {
 		obf = "updated"
 	}
 
@@ -89,8 +108,13 @@ func (i *Issue) Get() string {
 			q.Watching()
 		}
 
+		resolution := i.params.Resolution
+		if i.params.Open {
+			resolution = unresolved
+		}
+
 		q.FilterBy("type", i.params.IssueType).
-			FilterBy("resolution", i.params.Resolution).
+			FilterBy("resolution", resolutionFilter(resolution)).
 			FilterBy("priority", i.params.Priority).
 			FilterBy("reporter", i.params.Reporter).
 			FilterBy("assignee", i.params.Assignee).
@@ -119,15 +143,48 @@ func (i *Issue) Get() string {
 		}
 	})
 
-	if i.params.Reverse {
+	switch {
+	case !ordered:
+	case strings.EqualFold(obf, OrderByRank):
+		// The top of the board comes first, reverse lists it from the bottom
+		if i.params.Reverse {
+			q.OrderBy("Rank", jql.DirectionDescending)
+		} else {
+			q.OrderBy("Rank", jql.DirectionAscending)
+		}
+	case i.params.Reverse:
 		q.OrderBy(obf, jql.DirectionAscending)
-	} else {
+	default:
 		q.OrderBy(obf, jql.DirectionDescending)
 	}
 
 	return q.String()
 }
 
+// unresolved is the resolution of open issues, Jira doesn't set one until an issue is resolved.
+const unresolved = "Unresolved"
+
+// resolutionFilter turns "Unresolved" into a check for an empty resolution, as
+// in `jira issue list -R Unresolved` or `-R ~Unresolved`. Jira only supports it
+// unquoted, while the filter quotes the value.
+func resolutionFilter(resolution string) string {
+	name, negated := strings.CutPrefix(resolution, "~")
+	if !strings.EqualFold(name, unresolved) {
+		return resolution
+	}
+	if negated {
+		return "~x"
+	}
+	return "x"
+}
+
+// IsUnresolved tells if the resolution filter selects open issues, it isn't a
+// resolution name to be validated.
+func IsUnresolved(resolution string) bool {
+	name := strings.TrimPrefix(resolution, "~")
+	return name == "x" || strings.EqualFold(name, unresolved)
+}
+
 // Params returns issue command params.
 func (i *Issue) Params() *IssueParams {
 	return i.params
@@ -164,6 +221,9 @@ func (i *Issue) setCreatedFilters(q *jql.JQL) {
 	if i.params.CreatedBefore != "" {
 		q.Lt("createdDate", i.params.CreatedBefore, true)
 	}
+	if i.params.CreatedSince != "" {
+		q.Gte("createdDate", i.params.CreatedSince, true)
+	}
 }
 
 func (i *Issue) setUpdatedFilters(q *jql.JQL) {
@@ -177,6 +237,9 @@ func (i *Issue) setUpdatedFilters(q *jql.JQL) {
 	if i.params.UpdatedBefore != "" {
 		q.Lt("updatedDate", i.params.UpdatedBefore, true)
 	}
+	if i.params.UpdatedSince != "" {
+		q.Gte("updatedDate", i.params.UpdatedSince, true)
+	}
 }
 
 // IssueParams is issue command parameters.
@@ -184,6 +247,7 @@ type IssueParams struct {
 	Project       string
 	Latest        bool
 	Watching      bool
+	Open          bool
 	Resolution    string
 	IssueType     string
 	Parent        string
@@ -198,6 +262,8 @@ type IssueParams struct {
 	UpdatedAfter  string
 	CreatedBefore string
 	UpdatedBefore string
+	CreatedSince  string
+	UpdatedSince  string
 	Labels        []string
 	OrderBy       string
 	Reverse       bool
@@ -209,11 +275,11 @@ type IssueParams struct {
 }
 
 func (ip *IssueParams) initEmpty() {
-	boolParams := []string{"history", "watching", "reverse", "debug"}
+	boolParams := []string{"history", "watching", "open", "reverse", "debug"}
 	stringParams := []string{
 		"resolution", "type", "parent", "priority", "reporter", "assignee", "component",
 		"created", "created-after", "created-before", "updated", "updated-after", "updated-before",
-		"jql", "order-by", "paginate",
+		"created-since", "updated-since", "jql", "order-by", "paginate",
 	}
 
 	boolParamsMap := make(map[string]bool)
@@ -240,11 +306,11 @@ func (ip *IssueParams) initEmpty() {
 func (ip *IssueParams) init(flags FlagParser) error {
 	var err error
 
-	boolParams := []string{"history", "watching", "reverse", "debug"}
+	boolParams := []string{"history", "watching", "open", "reverse", "debug"}
 	stringParams := []string{
 		"resolution", "type", "parent", "priority", "reporter", "assignee", "component",
 		"created", "created-after", "created-before", "updated", "updated-after", "updated-before",
-		"jql", "order-by", "paginate",
+		"created-since", "updated-since", "jql", "order-by", "paginate",
 	}
 
 	boolParamsMap := make(map[string]bool)
@@ -271,6 +337,13 @@ func (ip *IssueParams) init(flags FlagParser) error {
 		return err
 	}
 
+	for _, param := range []string{"created-since", "updated-since"} {
+		stringParamsMap[param], err = parseSince(param, stringParamsMap[param])
+		if err != nil {
+			return err
+		}
+	}
+
 	paginate, err := flags.GetString("paginate")
 	if err != nil {
 		return err
@@ -297,6 +370,8 @@ func (ip *IssueParams) setBoolParams(paramsMap map[string]bool) {
 			ip.Latest = v
 		case "watching":
 			ip.Watching = v
+		case "open":
+			ip.Open = v
 		case "reverse":
 			ip.Reverse = v
 		case "debug":
@@ -334,6 +409,10 @@ func (ip *IssueParams) setStringParams(paramsMap map[string]string) {
 			ip.UpdatedAfter = v
 		case "updated-before":
 			ip.UpdatedBefore = v
+		case "created-since":
+			ip.CreatedSince = v
+		case "updated-since":
+			ip.UpdatedSince = v
 		case "jql":
 			ip.JQL = v
 		case "order-by":
@@ -358,6 +437,28 @@ func isValidDate(date string) (time.Time, string, bool) {
 	return time.Now(), "", false
 }
 
+var sincePeriod = regexp.MustCompile(`^-?\d+[wdhm]$`)
+
+// parseSince translates the value of a --*-since flag into a JQL date literal.
+// It accepts either a period going back from now, e.g. 7d or 2w, or a date.
+func parseSince(flag, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+	if sincePeriod.MatchString(value) {
+		return "-" + strings.TrimPrefix(value, "-"), nil
+	}
+	if _, _, ok := isValidDate(value); ok {
+		return value, nil
+	}
+	return "", fmt.Errorf(
+		"invalid argument for %s: %q, expected a period like 7d, 2w, 12h, 30m "+
+			"(w = weeks, d = days, h = hours, m = minutes) or a date in yyyy-mm-dd or yyyy/mm/dd format",
+		flag, value,
+	)
+}
+
 func addDay(dt time.Time, format string) string {
 	return dt.AddDate(0, 0, 1).Format(format)
 }
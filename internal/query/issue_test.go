@@ -21,6 +21,8 @@ type issueFlagParser struct {
 	err           issueParamsErr
 	noHistory     bool
 	noWatching    bool
+	open          bool
+	resolution    string
 	orderDesc     bool
 	emptyType     bool
 	labels        []string
@@ -33,6 +35,8 @@ type issueFlagParser struct {
 	createdBefore string
 	updatedAfter  string
 	updatedBefore string
+	createdSince  string
+	updatedSince  string
 	jql           string
 	orderBy       string
 }
@@ -53,6 +57,9 @@ func (tfp *issueFlagParser) GetBool(name string) (bool, error) {
 	if tfp.orderDesc && name == "reverse" {
 		return false, nil
 	}
+	if name == "open" {
+		return tfp.open, nil
+	}
 	return true, nil
 }
 
@@ -64,6 +71,9 @@ func (tfp *issueFlagParser) GetString(name string) (string, error) {
 	if tfp.err.issueType && name == "type" {
 		return "", fmt.Errorf("oops! couldn't fetch type flag")
 	}
+	if tfp.resolution != "" && name == "resolution" {
+		return tfp.resolution, nil
+	}
 	if tfp.created != "" && name == "created" {
 		return tfp.created, nil
 	}
@@ -79,6 +89,9 @@ func (tfp *issueFlagParser) GetString(name string) (string, error) {
 	if tfp.orderBy == "" && name == "order-by" {
 		return "created", nil
 	}
+	if name == "order-by" {
+		return tfp.orderBy, nil
+	}
 	if strings.HasPrefix(name, "created") {
 		if tfp.withCreated {
 			switch name {
@@ -86,6 +99,8 @@ func (tfp *issueFlagParser) GetString(name string) (string, error) {
 				return tfp.createdAfter, nil
 			case "created-before":
 				return tfp.createdBefore, nil
+			case "created-since":
+				return tfp.createdSince, nil
 			}
 		}
 		return "", nil
@@ -97,6 +112,8 @@ func (tfp *issueFlagParser) GetString(name string) (string, error) {
 				return tfp.updatedAfter, nil
 			case "updated-before":
 				return tfp.updatedBefore, nil
+			case "updated-since":
+				return tfp.updatedSince, nil
 			}
 		}
 		return "", nil
@@ -154,6 +171,28 @@ func TestIssueGet(t *testing.T) {
 				`type="test" AND resolution="test" AND priority="test" AND reporter="test" ` +
 				`AND assignee="test" AND component="test" AND parent="test" ORDER BY created ASC`,
 		},
+		{
+			name: "query ordered by board rank",
+			initialize: func() *Issue {
+				i, err := NewIssue("TEST", &issueFlagParser{noHistory: true, orderBy: "rank", orderDesc: true})
+				assert.NoError(t, err)
+				return i
+			},
+			expected: `project="TEST" AND issue IN watchedIssues() AND ` +
+				`type="test" AND resolution="test" AND priority="test" AND reporter="test" ` +
+				`AND assignee="test" AND component="test" AND parent="test" ORDER BY Rank ASC`,
+		},
+		{
+			name: "query ordered by board rank in reverse",
+			initialize: func() *Issue {
+				i, err := NewIssue("TEST", &issueFlagParser{noHistory: true, orderBy: "Rank"})
+				assert.NoError(t, err)
+				return i
+			},
+			expected: `project="TEST" AND issue IN watchedIssues() AND ` +
+				`type="test" AND resolution="test" AND priority="test" AND reporter="test" ` +
+				`AND assignee="test" AND component="test" AND parent="test" ORDER BY Rank DESC`,
+		},
 		{
 			name: "query only with fields filter",
 			initialize: func() *Issue {
@@ -165,6 +204,28 @@ func TestIssueGet(t *testing.T) {
 				`type="test" AND resolution="test" AND priority="test" AND reporter="test" ` +
 				`AND assignee="test" AND component="test" AND parent="test" ORDER BY created ASC`,
 		},
+		{
+			name: "query with open issues only",
+			initialize: func() *Issue {
+				i, err := NewIssue("TEST", &issueFlagParser{noHistory: true, noWatching: true, open: true})
+				assert.NoError(t, err)
+				return i
+			},
+			expected: `project="TEST" AND ` +
+				`type="test" AND resolution IS EMPTY AND priority="test" AND reporter="test" ` +
+				`AND assignee="test" AND component="test" AND parent="test" ORDER BY created ASC`,
+		},
+		{
+			name: "query with resolved issues only",
+			initialize: func() *Issue {
+				i, err := NewIssue("TEST", &issueFlagParser{noHistory: true, noWatching: true, resolution: "~unresolved"})
+				assert.NoError(t, err)
+				return i
+			},
+			expected: `project="TEST" AND ` +
+				`type="test" AND resolution IS NOT EMPTY AND priority="test" AND reporter="test" ` +
+				`AND assignee="test" AND component="test" AND parent="test" ORDER BY created ASC`,
+		},
 		{
 			name: "query with error when fetching history flag",
 			initialize: func() *Issue {
@@ -421,6 +482,47 @@ func TestIssueGet(t *testing.T) {
 				`AND parent="test" AND updatedDate>"2020-11-31" AND updatedDate<"2020-12-31" ` +
 				`ORDER BY updated ASC`,
 		},
+		{
+			name: "query with created-since and updated-since filter",
+			initialize: func() *Issue {
+				i, err := NewIssue("TEST", &issueFlagParser{
+					createdSince: "2024-01-01",
+					updatedSince: "7d",
+					withCreated:  true,
+					withUpdated:  true,
+					noHistory:    true,
+				})
+				assert.NoError(t, err)
+				return i
+			},
+			expected: `project="TEST" AND issue IN watchedIssues() AND type="test" AND resolution="test" ` +
+				`AND priority="test" AND reporter="test" AND assignee="test" AND component="test" ` +
+				`AND parent="test" AND createdDate>="2024-01-01" AND updatedDate>="-7d" ` +
+				`ORDER BY created ASC`,
+		},
+		{
+			name: "it orders by updated if only updated-since is present",
+			initialize: func() *Issue {
+				i, err := NewIssue("TEST", &issueFlagParser{
+					updatedSince: "-2w",
+					withUpdated:  true,
+					noHistory:    true,
+				})
+				assert.NoError(t, err)
+				return i
+			},
+			expected: `project="TEST" AND issue IN watchedIssues() AND type="test" AND resolution="test" ` +
+				`AND priority="test" AND reporter="test" AND assignee="test" AND component="test" ` +
+				`AND parent="test" AND updatedDate>="-2w" ORDER BY updated ASC`,
+		},
+		{
+			name: "query with invalid updated-since filter",
+			initialize: func() *Issue {
+				_, err := NewIssue("TEST", &issueFlagParser{updatedSince: "last week", withUpdated: true})
+				assert.ErrorContains(t, err, `invalid argument for updated-since: "last week"`)
+				return nil
+			},
+		},
 		{
 			name: "query with jql parameter",
 			initialize: func() *Issue {
@@ -447,3 +549,17 @@ func TestIssueGet(t *testing.T) {
 		})
 	}
 }
+
+func TestIssueGetForBoard(t *testing.T) {
+	ranked, err := NewIssue("TEST", &issueFlagParser{noHistory: true, orderBy: "rank", orderDesc: true})
+	assert.NoError(t, err)
+	assert.True(t, ranked.OrdersByRank())
+	assert.Equal(t, `project="TEST" AND issue IN watchedIssues() AND `+
+		`type="test" AND resolution="test" AND priority="test" AND reporter="test" `+
+		`AND assignee="test" AND component="test" AND parent="test"`, ranked.GetForBoard())
+
+	created, err := NewIssue("TEST", &issueFlagParser{noHistory: true})
+	assert.NoError(t, err)
+	assert.False(t, created.OrdersByRank())
+	assert.Equal(t, created.Get(), created.GetForBoard())
+}
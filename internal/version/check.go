@@ -0,0 +1,84 @@
+package version
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// LatestReleaseURL is the GitHub API endpoint describing the latest release.
+const LatestReleaseURL = "https://api.github.com/repos/Jorres/jira-tui/releases/latest"
+
+// ReleasesPage is where the released binaries can be downloaded.
+const ReleasesPage = "https://github.com/Jorres/jira-tui/releases/latest"
+
+// Latest fetches the tag of the latest release from url.
+func Latest(ctx context.Context, url string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected response from %s: %s", url, res.Status)
+	}
+
+	var out struct {
+		TagName string `json:"tag_name"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return "", err
+	}
+	return out.TagName, nil
+}
+
+// IsNewer tells if the latest version is newer than current. Versions that are
+// not in the vMAJOR.MINOR.PATCH form, e.g. development builds, are never older.
+func IsNewer(latest, current string) bool {
+	l, ok := parseVersion(latest)
+	if !ok {
+		return false
+	}
+	c, ok := parseVersion(current)
+	if !ok || current == "v0.0.0-dev" {
+		return false
+	}
+
+	for i := range l {
+		if l[i] != c[i] {
+			return l[i] > c[i]
+		}
+	}
+	return false
+}
+
+func parseVersion(v string) ([3]int, bool) {
+	var out [3]int
+
+	v = strings.TrimPrefix(v, "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	parts := strings.Split(v, ".")
+	if len(parts) != len(out) {
+		return out, false
+	}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return out, false
+		}
+		out[i] = n
+	}
+	return out, true
+}
@@ -0,0 +1,37 @@
+package version
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNewer(t *testing.T) {
+	assert.True(t, IsNewer("v1.2.0", "v1.1.9"))
+	assert.True(t, IsNewer("v2.0.0", "v1.10.3"))
+	assert.True(t, IsNewer("v1.1.10", "v1.1.9"))
+	assert.False(t, IsNewer("v1.1.9", "v1.1.9"))
+	assert.False(t, IsNewer("v1.1.8", "v1.1.9"))
+	assert.False(t, IsNewer("v1.2.0", "v0.0.0-dev"))
+	assert.False(t, IsNewer("v1.2.0", "main"))
+	assert.False(t, IsNewer("nightly", "v1.1.9"))
+}
+
+func TestLatest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"tag_name": "v1.4.0", "name": "v1.4.0"}`))
+	}))
+	defer server.Close()
+
+	tag, err := Latest(context.Background(), server.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, "v1.4.0", tag)
+
+	server.Close()
+	_, err = Latest(context.Background(), server.URL)
+	assert.Error(t, err)
+}
@@ -20,6 +20,9 @@ type EpicList struct {
 	Data    []*jira.Issue
 	Issues  EpicIssueFunc
 	Display DisplayFormat
+
+	// Progress of the epics, shown next to their titles if set.
+	Progress map[string]api.EpicProgress
 }
 
 // Render renders the epic explorer view.
@@ -76,9 +79,13 @@ func (el *EpicList) data() []tui.PreviewData {
 		},
 	})
 	for _, issue := range el.Data {
+		menu := fmt.Sprintf("➤ %s: %s", issue.Key, prepareTitle(issue.Fields.Summary))
+		if p, ok := el.Progress[issue.Key]; ok {
+			menu = fmt.Sprintf("%s %s", menu, progressBar(p))
+		}
 		data = append(data, tui.PreviewData{
 			Key:  issue.Key,
-			Menu: fmt.Sprintf("➤ %s: %s", issue.Key, prepareTitle(issue.Fields.Summary)),
+			Menu: menu,
 			Contents: func(key string) interface{} {
 				issues := el.Issues(key)
 				return el.tabularize(issues)
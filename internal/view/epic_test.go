@@ -18,20 +18,14 @@ func TestEpicData(t *testing.T) {
 				Name string `json:"name"`
 			}{Name: "Fixed"},
 			IssueType: jira.IssueType{Name: "Epic"},
-			Assignee: struct {
-				Name string `json:"displayName"`
-			}{Name: "Person A"},
+			Assignee:  jira.IssueUser{Name: "Person A"},
 			Priority: struct {
 				Name string `json:"name"`
 			}{Name: "High"},
-			Reporter: struct {
-				Name string `json:"displayName"`
-			}{Name: "Person Z"},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "Done"},
-			Created: "2020-12-13T14:05:20.974+0100",
-			Updated: "2020-12-13T14:07:20.974+0100",
+			Reporter: jira.IssueUser{Name: "Person Z"},
+			Status:   jira.IssueStatus{Name: "Done"},
+			Created:  "2020-12-13T14:05:20.974+0100",
+			Updated:  "2020-12-13T14:07:20.974+0100",
 		},
 	}
 	epic2 := jira.Issue{
@@ -42,14 +36,10 @@ func TestEpicData(t *testing.T) {
 			Priority: struct {
 				Name string `json:"name"`
 			}{Name: "Normal"},
-			Reporter: struct {
-				Name string `json:"displayName"`
-			}{Name: "Person A"},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "Open"},
-			Created: "2020-12-13T14:05:20.974+0100",
-			Updated: "2020-12-13T14:07:20.974+0100",
+			Reporter: jira.IssueUser{Name: "Person A"},
+			Status:   jira.IssueStatus{Name: "Open"},
+			Created:  "2020-12-13T14:05:20.974+0100",
+			Updated:  "2020-12-13T14:07:20.974+0100",
 		},
 	}
 
@@ -61,20 +51,14 @@ func TestEpicData(t *testing.T) {
 				Name string `json:"name"`
 			}{Name: "Fixed"},
 			IssueType: jira.IssueType{Name: "Bug"},
-			Assignee: struct {
-				Name string `json:"displayName"`
-			}{Name: "Person A"},
+			Assignee:  jira.IssueUser{Name: "Person A"},
 			Priority: struct {
 				Name string `json:"name"`
 			}{Name: "High"},
-			Reporter: struct {
-				Name string `json:"displayName"`
-			}{Name: "Person Z"},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "Done"},
-			Created: "2020-12-13T14:05:20.974+0100",
-			Updated: "2020-12-13T14:07:20.974+0100",
+			Reporter: jira.IssueUser{Name: "Person Z"},
+			Status:   jira.IssueStatus{Name: "Done"},
+			Created:  "2020-12-13T14:05:20.974+0100",
+			Updated:  "2020-12-13T14:07:20.974+0100",
 		},
 	}
 	issue2 := jira.Issue{
@@ -85,14 +69,10 @@ func TestEpicData(t *testing.T) {
 			Priority: struct {
 				Name string `json:"name"`
 			}{Name: "Normal"},
-			Reporter: struct {
-				Name string `json:"displayName"`
-			}{Name: "Person A"},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "Open"},
-			Created: "2020-12-13T14:05:20.974+0100",
-			Updated: "2020-12-13T14:07:20.974+0100",
+			Reporter: jira.IssueUser{Name: "Person A"},
+			Status:   jira.IssueStatus{Name: "Open"},
+			Created:  "2020-12-13T14:05:20.974+0100",
+			Updated:  "2020-12-13T14:07:20.974+0100",
 		},
 	}
 
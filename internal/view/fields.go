@@ -18,4 +18,5 @@ const (
 	fieldEndDate      = "END"
 	fieldCompleteDate = "COMPLETE"
 	fieldLabels       = "LABELS"
+	fieldProgress     = "PROGRESS"
 )
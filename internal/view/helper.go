@@ -15,6 +15,7 @@ import (
 	"github.com/mgutz/ansi"
 	"github.com/rivo/tview"
 
+	"github.com/jorres/jira-tui/api"
 	"github.com/jorres/jira-tui/internal/cmdutil"
 	"github.com/jorres/jira-tui/pkg/browser"
 	"github.com/jorres/jira-tui/pkg/tui"
@@ -90,8 +91,12 @@ func ValidSprintColumns() []string {
 
 // MDRenderer constructs markdown renderer.
 func MDRenderer() (*glamour.TermRenderer, error) {
+	style := glamour.WithEnvironmentConfig()
+	if !cmdutil.ColorEnabled() {
+		style = glamour.WithStandardStyle("notty")
+	}
 	return glamour.NewTermRenderer(
-		glamour.WithEnvironmentConfig(),
+		style,
 		glamour.WithWordWrap(wordWrap),
 	)
 }
@@ -175,6 +180,9 @@ func unescape(s string) string {
 }
 
 func coloredOut(msg string, clr color.Attribute, attrs ...color.Attribute) string {
+	if !cmdutil.ColorEnabled() {
+		return msg
+	}
 	c := color.New(clr).Add(attrs...)
 	return c.Sprint(msg)
 }
@@ -185,6 +193,9 @@ func xterm256() bool {
 }
 
 func gray(msg string) string {
+	if !cmdutil.ColorEnabled() {
+		return msg
+	}
 	if xterm256() {
 		return gray256(msg)
 	}
@@ -192,9 +203,23 @@ func gray(msg string) string {
 }
 
 func gray256(msg string) string {
+	if !cmdutil.ColorEnabled() {
+		return msg
+	}
 	return fmt.Sprintf("\x1b[38;5;242m%s\x1b[m", msg)
 }
 
+// progressBar renders epic completion, e.g. "▓▓▓░░ 60%".
+func progressBar(p api.EpicProgress) string {
+	const width = 5
+
+	filled := 0
+	if p.Total > 0 {
+		filled = p.Done * width / p.Total
+	}
+	return fmt.Sprintf("%s%s %d%%", strings.Repeat("▓", filled), strings.Repeat("░", width-filled), p.Percent())
+}
+
 func shortenAndPad(msg string, limit int) string {
 	if limit > 1 && len(msg) > limit {
 		return msg[0:limit-1] + "…"
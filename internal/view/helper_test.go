@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/jorres/jira-tui/api"
 	"github.com/jorres/jira-tui/pkg/jira"
 )
 
@@ -194,3 +195,12 @@ func TestMax(t *testing.T) {
 		})
 	}
 }
+
+func TestProgressBar(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "░░░░░ 0%", progressBar(api.EpicProgress{}))
+	assert.Equal(t, "▓▓▓░░ 60%", progressBar(api.EpicProgress{Done: 3, Total: 5}))
+	assert.Equal(t, "▓░░░░ 33%", progressBar(api.EpicProgress{Done: 1, Total: 3}))
+	assert.Equal(t, "▓▓▓▓▓ 100%", progressBar(api.EpicProgress{Done: 7, Total: 7}))
+}
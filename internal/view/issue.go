@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"sort"
 	"strings"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/jorres/md2adf-translator/adf2md"
 
 	"github.com/jorres/jira-tui/internal/cmdutil"
+	"github.com/jorres/jira-tui/internal/editing"
 	"github.com/jorres/jira-tui/pkg/jira"
 	"github.com/jorres/jira-tui/pkg/md"
 	"github.com/jorres/jira-tui/pkg/tui"
@@ -95,6 +97,10 @@ func (i Issue) String() string {
 
 	s.WriteString(i.header())
 
+	if fields := i.customFields("%s: %s\n"); fields != "" {
+		s.WriteString(fmt.Sprintf("\n\n%s", fields))
+	}
+
 	desc := i.description()
 	if desc != "" {
 		s.WriteString(fmt.Sprintf("\n\n%s\n\n%s", i.separator("Description"), desc))
@@ -129,6 +135,10 @@ func (i Issue) fragments() []fragment {
 		{Body: i.header(), Parse: true},
 	}
 
+	if fields := i.customFields("- **%s:** %s\n"); fields != "" {
+		scraps = append(scraps, fragment{Body: fields, Parse: true})
+	}
+
 	desc := i.description()
 	if desc != "" {
 		scraps = append(
@@ -207,7 +217,7 @@ func (i Issue) header() string {
 		as = "Unassigned"
 	}
 	st, sti := i.Data.Fields.Status.Name, "🚧"
-	if st == "Done" {
+	if cmdutil.IsDoneStatus(i.Data.Fields.Status) {
 		sti = "✅"
 	}
 	lbl := "None"
@@ -222,10 +232,8 @@ func (i Issue) header() string {
 	if len(components) > 0 {
 		cmpt = strings.Join(components, ", ")
 	}
-	it, iti := i.Data.Fields.IssueType.Name, "⭐"
-	if it == "Bug" {
-		iti = "🐞"
-	}
+	it := i.Data.Fields.IssueType.Name
+	iti, _ := cmdutil.IssueTypeIcon(it)
 	wch := fmt.Sprintf("%d watchers", i.Data.Fields.Watches.WatchCount)
 	if i.Data.Fields.Watches.WatchCount == 1 && i.Data.Fields.Watches.IsWatching {
 		wch = "You are watching"
@@ -242,6 +250,31 @@ func (i Issue) header() string {
 	)
 }
 
+// customFields lists the extra fields requested with --fields, sorted by name.
+func (i Issue) customFields(format string) string {
+	fields := i.Data.ExtraFields
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var s strings.Builder
+	for _, name := range names {
+		s.WriteString(fmt.Sprintf(format, name, fields[name]))
+	}
+	return s.String()
+}
+
+// adfTranslator translates ADF to markdown, with panels and expands kept as ::: blocks.
+func adfTranslator() *adf2md.Translator {
+	return adf2md.NewTranslator(adf2md.NewMarkdownTranslator(
+		adf2md.WithMarkdownOpenHooks(editing.BlockOpenHooks()),
+		adf2md.WithMarkdownCloseHooks(editing.BlockCloseHooks()),
+	))
+}
+
 func (i Issue) description() string {
 	if i.Data.Fields.Description == nil {
 		return ""
@@ -250,7 +283,7 @@ func (i Issue) description() string {
 	var desc string
 
 	if adfNode, ok := i.Data.Fields.Description.(*adf.ADFNode); ok {
-		desc = adf2md.NewTranslator(adf2md.NewMarkdownTranslator()).Translate(adfNode)
+		desc = adfTranslator().Translate(adfNode)
 	} else {
 		desc = i.Data.Fields.Description.(string)
 		desc = md.FromJiraMD(desc)
@@ -397,7 +430,7 @@ func (i Issue) comments() []issueComment {
 		c := i.Data.Fields.Comment.Comments[idx]
 		var body string
 		if adfNode, ok := c.Body.(*adf.ADFNode); ok {
-			body = adf2md.NewTranslator(adf2md.NewMarkdownTranslator()).Translate(adfNode)
+			body = adfTranslator().Translate(adfNode)
 		} else {
 			body = c.Body.(string)
 			body = md.FromJiraMD(body)
@@ -416,12 +449,18 @@ func (i Issue) comments() []issueComment {
 		if idx == total-1 {
 			meta += fmt.Sprintf(" • %s", coloredOut("Latest comment", color.FgCyan, color.Bold))
 		}
+		if c.IsInternal() {
+			meta += fmt.Sprintf(" • %s", coloredOut("[internal]", color.FgYellow, color.Bold))
+		}
 		comments = append(comments, issueComment{
 			meta: meta,
 			body: body,
 		})
 	}
 
+	if cmdutil.CommentsOldestFirst() {
+		slices.Reverse(comments)
+	}
 	return comments
 }
 
@@ -35,18 +35,12 @@ func TestIssueDetailsRenderInPlainView(t *testing.T) {
 				},
 			},
 			IssueType: jira.IssueType{Name: "Bug"},
-			Assignee: struct {
-				Name string `json:"displayName"`
-			}{Name: "Person A"},
+			Assignee:  jira.IssueUser{Name: "Person A"},
 			Priority: struct {
 				Name string `json:"name"`
 			}{Name: "High"},
-			Reporter: struct {
-				Name string `json:"displayName"`
-			}{Name: "Person Z"},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "Done"},
+			Reporter: jira.IssueUser{Name: "Person Z"},
+			Status:   jira.IssueStatus{Name: "Done"},
 			Components: []struct {
 				Name string `json:"name"`
 			}{{Name: "BE"}, {Name: "FE"}},
@@ -95,18 +89,12 @@ func TestIssueDetailsWithV2Description(t *testing.T) {
 			}{Name: "Fixed"},
 			Description: "h1. Title\nh2. Subtitle\n\nThis is a *bold* and _italic_ text with [a link|https://ankit.pl] in between.",
 			IssueType:   jira.IssueType{Name: "Bug"},
-			Assignee: struct {
-				Name string `json:"displayName"`
-			}{Name: "Person A"},
+			Assignee:    jira.IssueUser{Name: "Person A"},
 			Priority: struct {
 				Name string `json:"name"`
 			}{Name: "High"},
-			Reporter: struct {
-				Name string `json:"displayName"`
-			}{Name: "Person Z"},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "Done"},
+			Reporter: jira.IssueUser{Name: "Person Z"},
+			Status:   jira.IssueStatus{Name: "Done"},
 			Components: []struct {
 				Name string `json:"name"`
 			}{{Name: "BE"}, {Name: "FE"}},
@@ -114,12 +102,7 @@ func TestIssueDetailsWithV2Description(t *testing.T) {
 				Comments jira.Comments `json:"comments"`
 				Total    int           `json:"total"`
 			}{
-				Comments: []struct {
-					ID      string      `json:"id"`
-					Author  jira.User   `json:"author"`
-					Body    interface{} `json:"body"`
-					Created string      `json:"created"`
-				}{
+				Comments: jira.Comments{
 					{ID: "10033", Author: jira.User{Name: "Person A"}, Body: "Test comment A", Created: "2021-11-22T23:44:13.782+0100"},
 					{ID: "10034", Author: jira.User{Name: "Person B"}, Body: "Test comment B", Created: "2021-11-23T23:44:13.782+0100"},
 					{ID: "10035", Author: jira.User{Name: "Person C"}, Body: "Test comment C", Created: "2021-11-24T23:44:13.782+0100"},
@@ -131,9 +114,7 @@ func TestIssueDetailsWithV2Description(t *testing.T) {
 					Key: "TEST-2",
 					Fields: jira.IssueFields{
 						Summary: "Subtask 1",
-						Status: struct {
-							Name string `json:"name"`
-						}{Name: "TO DO"},
+						Status:  jira.IssueStatus{Name: "TO DO"},
 						Priority: struct {
 							Name string `json:"name"`
 						}{Name: "High"},
@@ -143,9 +124,7 @@ func TestIssueDetailsWithV2Description(t *testing.T) {
 					Key: "TEST-3",
 					Fields: jira.IssueFields{
 						Summary: "Subtask 2",
-						Status: struct {
-							Name string `json:"name"`
-						}{Name: "Done"},
+						Status:  jira.IssueStatus{Name: "Done"},
 						Priority: struct {
 							Name string `json:"name"`
 						}{Name: "Normal"},
@@ -175,9 +154,7 @@ func TestIssueDetailsWithV2Description(t *testing.T) {
 							IssueType: jira.IssueType{Name: "Bug"},
 							Priority: struct {
 								Name string `json:"name"`
-							}{Name: "High"}, Status: struct {
-								Name string `json:"name"`
-							}{Name: "TO DO"},
+							}{Name: "High"}, Status: jira.IssueStatus{Name: "TO DO"},
 						},
 					},
 				},
@@ -194,9 +171,7 @@ func TestIssueDetailsWithV2Description(t *testing.T) {
 							IssueType: jira.IssueType{Name: "Bug"},
 							Priority: struct {
 								Name string `json:"name"`
-							}{Name: "Urgent"}, Status: struct {
-								Name string `json:"name"`
-							}{Name: "Done"},
+							}{Name: "Urgent"}, Status: jira.IssueStatus{Name: "Done"},
 						},
 					},
 				},
@@ -33,6 +33,9 @@ type IssueList struct {
 	Display    DisplayFormat
 	Refresh    tui.RefreshFunc
 	FooterText string
+
+	// Progress of epics in the list, adds the PROGRESS column if set.
+	Progress map[string]api.EpicProgress
 }
 
 // Render renders the view.
@@ -139,6 +142,14 @@ func (*IssueList) validColumnsMap() map[string]struct{} {
 }
 
 func (l *IssueList) header() []string {
+	headers := l.columns()
+	if l.Progress != nil {
+		headers = append(headers, fieldProgress)
+	}
+	return headers
+}
+
+func (l *IssueList) columns() []string {
 	if len(l.Display.Columns) == 0 {
 		validColumns := ValidIssueColumns()
 		if l.Display.NoTruncate || !l.Display.Plain {
@@ -216,6 +227,12 @@ func (l *IssueList) assignColumns(columns []string, issue *jira.Issue) []string
 			bucket = append(bucket, formatDateTime(issue.Fields.Updated, jira.RFC3339, l.Display.Timezone))
 		case fieldLabels:
 			bucket = append(bucket, strings.Join(issue.Fields.Labels, ","))
+		case fieldProgress:
+			if p, ok := l.Progress[issue.Key]; ok {
+				bucket = append(bucket, progressBar(p))
+			} else {
+				bucket = append(bucket, "")
+			}
 		}
 	}
 
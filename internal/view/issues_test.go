@@ -141,21 +141,15 @@ func getIssues() []*jira.Issue {
 					Name string `json:"name"`
 				}{Name: "Fixed"},
 				IssueType: jira.IssueType{Name: "Bug"},
-				Assignee: struct {
-					Name string `json:"displayName"`
-				}{Name: "Person A"},
+				Assignee:  jira.IssueUser{Name: "Person A"},
 				Priority: struct {
 					Name string `json:"name"`
 				}{Name: "High"},
-				Reporter: struct {
-					Name string `json:"displayName"`
-				}{Name: "Person Z"},
-				Status: struct {
-					Name string `json:"name"`
-				}{Name: "Done"},
-				Created: "2020-12-13T14:05:20.974+0100",
-				Updated: "2020-12-13T14:07:20.974+0100",
-				Labels:  []string{"krakatit"},
+				Reporter: jira.IssueUser{Name: "Person Z"},
+				Status:   jira.IssueStatus{Name: "Done"},
+				Created:  "2020-12-13T14:05:20.974+0100",
+				Updated:  "2020-12-13T14:07:20.974+0100",
+				Labels:   []string{"krakatit"},
 			},
 		},
 		{
@@ -166,15 +160,11 @@ func getIssues() []*jira.Issue {
 				Priority: struct {
 					Name string `json:"name"`
 				}{Name: "Normal"},
-				Reporter: struct {
-					Name string `json:"displayName"`
-				}{Name: "Person A"},
-				Status: struct {
-					Name string `json:"name"`
-				}{Name: "Open"},
-				Created: "2020-12-13T14:05:20.974+0100",
-				Updated: "2020-12-13T14:07:20.974+0100",
-				Labels:  []string{"pat", "mat"},
+				Reporter: jira.IssueUser{Name: "Person A"},
+				Status:   jira.IssueStatus{Name: "Open"},
+				Created:  "2020-12-13T14:05:20.974+0100",
+				Updated:  "2020-12-13T14:07:20.974+0100",
+				Labels:   []string{"pat", "mat"},
 			},
 		},
 	}
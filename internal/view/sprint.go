@@ -226,3 +226,102 @@ func (sl *SprintList) assignColumns(columns []string, sprint *jira.Sprint) []str
 
 	return bucket
 }
+
+// ActiveSprint is a plain view of a sprint's issues grouped by status.
+type ActiveSprint struct {
+	Sprint *jira.Sprint
+	Data   []*jira.Issue
+	// Now is the reference time used to compute remaining days, defaults to time.Now.
+	Now time.Time
+	// Timezone tells the days apart, defaults to the local timezone.
+	Timezone string
+}
+
+// Render renders the sprint header followed by its issues grouped by status.
+func (as *ActiveSprint) Render() error {
+	w := tabwriter.NewWriter(os.Stdout, 0, tabWidth, 1, '\t', 0)
+	return as.renderPlain(w)
+}
+
+func (as *ActiveSprint) renderPlain(w io.Writer) error {
+	_, _ = fmt.Fprintf(
+		w, "Sprint #%d ➤ %s (%s - %s) • %s\n",
+		as.Sprint.ID,
+		as.Sprint.Name,
+		cmdutil.FormatDateTimeHuman(as.Sprint.StartDate, time.RFC3339),
+		cmdutil.FormatDateTimeHuman(as.Sprint.EndDate, time.RFC3339),
+		as.remaining(),
+	)
+
+	var statuses []string
+	groups := make(map[string][]*jira.Issue)
+	for _, iss := range as.Data {
+		st := iss.Fields.Status.Name
+		if _, ok := groups[st]; !ok {
+			statuses = append(statuses, st)
+		}
+		groups[st] = append(groups[st], iss)
+	}
+
+	for _, st := range statuses {
+		_, _ = fmt.Fprintf(w, "\n%s (%d)\n", strings.ToUpper(st), len(groups[st]))
+		for _, iss := range groups[st] {
+			assignee := iss.Fields.Assignee.Name
+			if assignee == "" {
+				assignee = "Unassigned"
+			}
+			_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\n", iss.Key, strings.TrimSpace(iss.Fields.Summary), assignee)
+		}
+	}
+
+	if tw, ok := w.(*tabwriter.Writer); ok {
+		return tw.Flush()
+	}
+	return nil
+}
+
+// remaining counts the calendar days left until the day the sprint ends,
+// weekends and holidays included, since the board doesn't tell which days are
+// worked. Days are told apart in the configured timezone.
+func (as *ActiveSprint) remaining() string {
+	end, err := time.Parse(time.RFC3339, as.Sprint.EndDate)
+	if err != nil {
+		return "no end date"
+	}
+
+	now := as.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	loc := time.Local
+	if as.Timezone != "" {
+		if l, err := time.LoadLocation(as.Timezone); err == nil {
+			loc = l
+		}
+	}
+	days := calendarDays(now.In(loc), end.In(loc))
+
+	switch {
+	case now.After(end) && days == 0:
+		return "ended today"
+	case now.After(end):
+		return fmt.Sprintf("overdue by %d day(s)", -days)
+	case days == 0:
+		return "ends today"
+	case days == 1:
+		return "1 day remaining"
+	default:
+		return fmt.Sprintf("%d days remaining", days)
+	}
+}
+
+// calendarDays counts the days between the dates of from and to, ignoring the
+// time of the day.
+func calendarDays(from, to time.Time) int {
+	date := func(t time.Time) time.Time {
+		y, m, d := t.Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	return int(date(to).Sub(date(from)).Hours() / 24)
+}
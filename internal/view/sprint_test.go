@@ -3,6 +3,7 @@ package view
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -36,21 +37,15 @@ func TestSprintPreviewLayoutData(t *testing.T) {
 				Name string `json:"name"`
 			}{Name: "Fixed"},
 			IssueType: jira.IssueType{Name: "Bug"},
-			Assignee: struct {
-				Name string `json:"displayName"`
-			}{Name: "Person A"},
+			Assignee:  jira.IssueUser{Name: "Person A"},
 			Priority: struct {
 				Name string `json:"name"`
 			}{Name: "High"},
-			Reporter: struct {
-				Name string `json:"displayName"`
-			}{Name: "Person Z"},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "Done"},
-			Created: "2020-12-13T14:05:20.974+0100",
-			Updated: "2020-12-13T14:07:20.974+0100",
-			Labels:  []string{"urgent"},
+			Reporter: jira.IssueUser{Name: "Person Z"},
+			Status:   jira.IssueStatus{Name: "Done"},
+			Created:  "2020-12-13T14:05:20.974+0100",
+			Updated:  "2020-12-13T14:07:20.974+0100",
+			Labels:   []string{"urgent"},
 		},
 	}
 	issue2 := jira.Issue{
@@ -61,15 +56,11 @@ func TestSprintPreviewLayoutData(t *testing.T) {
 			Priority: struct {
 				Name string `json:"name"`
 			}{Name: "Normal"},
-			Reporter: struct {
-				Name string `json:"displayName"`
-			}{Name: "Person A"},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "Open"},
-			Created: "2020-12-13T14:05:20.974+0100",
-			Updated: "2020-12-13T14:07:20.974+0100",
-			Labels:  []string{"blocked"},
+			Reporter: jira.IssueUser{Name: "Person A"},
+			Status:   jira.IssueStatus{Name: "Open"},
+			Created:  "2020-12-13T14:05:20.974+0100",
+			Updated:  "2020-12-13T14:07:20.974+0100",
+			Labels:   []string{"blocked"},
 		},
 	}
 
@@ -298,3 +289,68 @@ Sprint 2	2020-12-13 16:12:00	2020-12-19 16:12:00
 `
 	assert.Equal(t, expected, b.String())
 }
+
+func TestActiveSprintRenderGroupsByStatus(t *testing.T) {
+	var b bytes.Buffer
+
+	newIssue := func(key, summary, status, assignee string) *jira.Issue {
+		iss := &jira.Issue{Key: key}
+		iss.Fields.Summary = summary
+		iss.Fields.Status.Name = status
+		iss.Fields.Assignee.Name = assignee
+		return iss
+	}
+
+	sprint := ActiveSprint{
+		Sprint: &jira.Sprint{
+			ID:        2,
+			Name:      "Sprint 2",
+			Status:    "active",
+			StartDate: "2020-12-13T16:12:00.000Z",
+			EndDate:   "2020-12-19T16:12:00.000Z",
+		},
+		Data: []*jira.Issue{
+			newIssue("ISSUE-1", "First", "In Progress", "Person A"),
+			newIssue("ISSUE-2", "Second", "To Do", ""),
+			newIssue("ISSUE-3", "Third", "In Progress", "Person B"),
+		},
+		Now:      time.Date(2020, 12, 16, 10, 0, 0, 0, time.UTC),
+		Timezone: "UTC",
+	}
+	assert.NoError(t, sprint.renderPlain(&b))
+
+	expected := `Sprint #2 ➤ Sprint 2 (Sun, 13 Dec 20 - Sat, 19 Dec 20) • 3 days remaining
+
+IN PROGRESS (2)
+  ISSUE-1	First	Person A
+  ISSUE-3	Third	Person B
+
+TO DO (1)
+  ISSUE-2	Second	Unassigned
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestActiveSprintRemaining(t *testing.T) {
+	sprint := ActiveSprint{Sprint: &jira.Sprint{EndDate: "2020-12-19T16:12:00.000Z"}, Timezone: "UTC"}
+
+	sprint.Now = time.Date(2020, 12, 18, 20, 0, 0, 0, time.UTC)
+	assert.Equal(t, "1 day remaining", sprint.remaining())
+
+	sprint.Now = time.Date(2020, 12, 19, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, "ends today", sprint.remaining())
+
+	sprint.Now = time.Date(2020, 12, 19, 17, 0, 0, 0, time.UTC)
+	assert.Equal(t, "ended today", sprint.remaining())
+
+	sprint.Now = time.Date(2020, 12, 22, 1, 0, 0, 0, time.UTC)
+	assert.Equal(t, "overdue by 3 day(s)", sprint.remaining())
+
+	// it already is the 20th in Tokyo when the sprint ends
+	sprint.Timezone = "Asia/Tokyo"
+	sprint.Now = time.Date(2020, 12, 19, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, "1 day remaining", sprint.remaining())
+
+	sprint.Sprint.EndDate = ""
+	assert.Equal(t, "no end date", sprint.remaining())
+}
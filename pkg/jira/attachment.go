@@ -0,0 +1,55 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// GetAttachments fetches the attachments of an issue using GET /issue/{key} endpoint.
+func (c *Client) GetAttachments(key string) ([]Attachment, error) {
+	res, err := c.GetV2(context.Background(), fmt.Sprintf("/issue/%s?fields=attachment", key), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		Fields struct {
+			Attachments []Attachment `json:"attachment"`
+		} `json:"fields"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return out.Fields.Attachments, nil
+}
+
+// DownloadAttachment writes the content of the attachment to w. The content
+// URL is the one Jira gives for the attachment, it needs the same auth as the API.
+func (c *Client) DownloadAttachment(a Attachment, w io.Writer) error {
+	res, err := c.request(context.Background(), http.MethodGet, a.Content, nil, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return formatUnexpectedResponse(res)
+	}
+
+	_, err = io.Copy(w, res.Body)
+	return err
+}
@@ -0,0 +1,72 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAttachments(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+		assert.Equal(t, "attachment", r.URL.Query().Get("fields"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile("./testdata/attachments.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetAttachments("TEST-1")
+	assert.NoError(t, err)
+
+	expected := []Attachment{{
+		ID:       "10100",
+		Filename: "screenshot.png",
+		MimeType: "image/png",
+		Size:     23123,
+		Content:  "https://example.atlassian.net/rest/api/2/attachment/content/10100",
+		Author:   User{AccountID: "5b10ac8d82e05b22cc7d4ef5", DisplayName: "Person A", Active: true},
+		Created:  "2024-03-01T10:00:00.000+0000",
+	}}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetAttachments("TEST-1")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/attachment/content/10100", r.URL.Path)
+		assert.NotEmpty(t, r.Header.Get("Authorization"))
+
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte("PNG"))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL, Login: "jane", APIToken: "token"}, WithTimeout(3*time.Second))
+
+	var out strings.Builder
+	err := client.DownloadAttachment(Attachment{Content: server.URL + "/rest/api/2/attachment/content/10100"}, &out)
+	assert.NoError(t, err)
+	assert.Equal(t, "PNG", out.String())
+}
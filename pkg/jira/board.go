@@ -49,6 +49,28 @@ func (c *Client) BoardSearch(project, name string) (*BoardResult, error) {
 	return c.board(path)
 }
 
+// GetBoard fetches a board using GET /board/{boardId} endpoint.
+func (c *Client) GetBoard(id int) (*Board, error) {
+	res, err := c.GetV1Agile(context.Background(), fmt.Sprintf("/board/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Board
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
 func (c *Client) board(path string) (*BoardResult, error) {
 	res, err := c.GetV1Agile(context.Background(), path, nil)
 	if err != nil {
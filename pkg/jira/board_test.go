@@ -81,3 +81,26 @@ func TestBoards(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
 }
+
+func TestGetBoard(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/agile/1.0/board/1":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"id": 1, "name": "Board 1", "type": "scrum"}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetBoard(1)
+	assert.NoError(t, err)
+	assert.Equal(t, &Board{ID: 1, Name: "Board 1", Type: "scrum"}, actual)
+
+	_, err = client.GetBoard(2)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -48,9 +48,15 @@ type ErrUnexpectedResponse struct {
 	Body       Errors
 	Status     string
 	StatusCode int
+	// AuthType is the authentication the request was rejected with, only set on 401.
+	AuthType AuthType
 }
 
 func (e *ErrUnexpectedResponse) Error() string {
+	if e.StatusCode == http.StatusUnauthorized {
+		return e.Body.String() + "\n" + unauthorizedMessage(e.AuthType)
+	}
+
 	dm := fmt.Sprintf(
 		"\njira: Received unexpected response '%s'.\nPlease check the parameters you supplied and try again.",
 		e.Status,
@@ -330,9 +336,41 @@ func formatUnexpectedResponse(res *http.Response) *ErrUnexpectedResponse {
 	// We don't care about decoding error here.
 	_ = json.NewDecoder(res.Body).Decode(&b)
 
-	return &ErrUnexpectedResponse{
+	e := &ErrUnexpectedResponse{
 		Body:       b,
 		Status:     res.Status,
 		StatusCode: res.StatusCode,
 	}
+	if res.StatusCode == http.StatusUnauthorized && res.Request != nil {
+		e.AuthType = requestAuthType(res.Request)
+	}
+	return e
+}
+
+// requestAuthType tells the authentication used by the request from its header,
+// a request without credentials relies on the client certificate.
+func requestAuthType(req *http.Request) AuthType {
+	auth := req.Header.Get("Authorization")
+	switch {
+	case strings.HasPrefix(auth, "Basic "):
+		return AuthTypeBasic
+	case strings.HasPrefix(auth, "Bearer "):
+		return AuthTypeBearer
+	}
+	return AuthTypeMTLS
+}
+
+// unauthorizedMessage explains a 401 for the authentication that was rejected.
+func unauthorizedMessage(at AuthType) string {
+	switch at {
+	case AuthTypeBearer:
+		return "jira: Bearer token rejected (401 Unauthorized), the personal access token may be expired or revoked.\n" +
+			"Create a new token in your Jira profile and update JIRA_API_TOKEN, .netrc or the keyring with 'jira auth set'."
+	case AuthTypeMTLS:
+		return "jira: Authentication failed (401 Unauthorized) with mTLS.\n" +
+			"Check the client certificate and key configured in the mtls section of the config."
+	}
+	return "jira: Basic auth failed (401 Unauthorized), check the login and the API token.\n" +
+		"On Jira Cloud the login is your email and the token comes from https://id.atlassian.com/manage-profile/security/api-tokens,\n" +
+		"on Jira Server and Data Center use your username and password, or auth_type: bearer with a personal access token."
 }
@@ -0,0 +1,77 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Component holds project component info.
+type Component struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	Description      string `json:"description,omitempty"`
+	AssigneeType     string `json:"assigneeType,omitempty"`
+	Assignee         *User  `json:"assignee,omitempty"`
+	RealAssigneeType string `json:"realAssigneeType,omitempty"`
+	RealAssignee     *User  `json:"realAssignee,omitempty"`
+	Lead             *User  `json:"lead,omitempty"`
+}
+
+// DefaultAssignee returns the user new issues with the component are assigned to, if any.
+func (c *Component) DefaultAssignee() *User {
+	if c.RealAssignee != nil {
+		return c.RealAssignee
+	}
+	return c.Assignee
+}
+
+// ProjectComponents fetches components of a project using GET /project/{key}/components endpoint.
+func (c *Client) ProjectComponents(project string) ([]*Component, error) {
+	path := fmt.Sprintf("/project/%s/components", url.PathEscape(project))
+
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*Component
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out, err
+}
+
+// GetComponent fetches a component using GET /component/{id} endpoint.
+func (c *Client) GetComponent(id string) (*Component, error) {
+	path := fmt.Sprintf("/component/%s", url.PathEscape(id))
+
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Component
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
@@ -0,0 +1,91 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectComponents(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/project/TEST/components", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile("./testdata/components.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.ProjectComponents("TEST")
+	assert.NoError(t, err)
+
+	expected := []*Component{
+		{ID: "10000", Name: "Backend"},
+		{ID: "10001", Name: "Frontend"},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.ProjectComponents("TEST")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetComponent(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/component/10000", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile("./testdata/component.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetComponent("10000")
+	assert.NoError(t, err)
+
+	user := &User{AccountID: "a-123", DisplayName: "Person A", Active: true}
+	expected := &Component{
+		ID:               "10000",
+		Name:             "Backend",
+		Description:      "Services and APIs",
+		AssigneeType:     "COMPONENT_LEAD",
+		Assignee:         user,
+		RealAssigneeType: "COMPONENT_LEAD",
+		RealAssignee:     user,
+		Lead:             user,
+	}
+	assert.Equal(t, expected, actual)
+	assert.Equal(t, user, actual.DefaultAssignee())
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetComponent("10000")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -3,8 +3,11 @@ package jira
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 // CreateMetaRequest struct holds request data for createmeta request.
@@ -98,3 +101,129 @@ func (c *Client) GetCreateMetaForJiraServerV9(req *CreateMetaRequest) (*CreateMe
 
 	return &out, err
 }
+
+// GetCreateMetaIssueTypes lists the issue types available in the project. Jira
+// server 9 and above dropped the createmeta endpoint listing all projects, the
+// project specific one is used instead.
+func (c *Client) GetCreateMetaIssueTypes(project string) ([]*IssueType, error) {
+	meta, err := c.GetCreateMeta(&CreateMetaRequest{Projects: project})
+	if err == nil {
+		if len(meta.Projects) == 0 {
+			return nil, fmt.Errorf("project %q not found", project)
+		}
+		types := make([]*IssueType, 0, len(meta.Projects[0].IssueTypes))
+		for _, it := range meta.Projects[0].IssueTypes {
+			types = append(types, &it.IssueType)
+		}
+		return types, nil
+	}
+
+	var e *ErrUnexpectedResponse
+	if !errors.As(err, &e) || e.StatusCode != http.StatusNotFound {
+		return nil, err
+	}
+
+	metaV9, err := c.GetCreateMetaForJiraServerV9(&CreateMetaRequest{Projects: project})
+	if err != nil {
+		return nil, err
+	}
+	types := make([]*IssueType, 0, len(metaV9.Values))
+	for _, it := range metaV9.Values {
+		types = append(types, &IssueType{ID: it.ID, Name: it.Name, Subtask: it.Subtask})
+	}
+	return types, nil
+}
+
+// CreateMetaFieldsResponseJiraServerV9 struct holds response from GET
+// /issue/createmeta/{project}/issuetypes/{id} endpoint for jira server 9 and above.
+type CreateMetaFieldsResponseJiraServerV9 struct {
+	Values []IssueTypeField `json:"values"`
+}
+
+// GetCreateMetaFieldsForJiraServerV9 gets the create screen fields of the issue
+// type using GET /issue/createmeta/{project}/issuetypes/{id} endpoint for jira server 9 and above.
+func (c *Client) GetCreateMetaFieldsForJiraServerV9(project, issueTypeID string) (*CreateMetaFieldsResponseJiraServerV9, error) {
+	path := fmt.Sprintf("/issue/createmeta/%s/issuetypes/%s", project, issueTypeID)
+
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out CreateMetaFieldsResponseJiraServerV9
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// GetCreateMetaIssueTypeFields gets the create screen fields of the issue type
+// in the project keyed by field ID, nil if the project has no such type. Jira
+// server 9 and above serve them per issue type, like GetCreateMetaIssueTypes
+// the project specific endpoints are used there.
+func (c *Client) GetCreateMetaIssueTypeFields(project, issueType string) (map[string]IssueTypeField, error) {
+	meta, err := c.GetCreateMeta(&CreateMetaRequest{
+		Projects:       project,
+		IssueTypeNames: url.QueryEscape(issueType),
+		Expand:         "projects.issuetypes.fields",
+	})
+	if err == nil {
+		if len(meta.Projects) == 0 {
+			return nil, nil
+		}
+		for _, it := range meta.Projects[0].IssueTypes {
+			if strings.EqualFold(it.Name, issueType) {
+				return it.Fields, nil
+			}
+		}
+		return nil, nil
+	}
+
+	var e *ErrUnexpectedResponse
+	if !errors.As(err, &e) || e.StatusCode != http.StatusNotFound {
+		return nil, err
+	}
+
+	types, err := c.GetCreateMetaForJiraServerV9(&CreateMetaRequest{Projects: project})
+	if err != nil {
+		return nil, err
+	}
+	for _, it := range types.Values {
+		if !strings.EqualFold(it.Name, issueType) {
+			continue
+		}
+
+		metaV9, err := c.GetCreateMetaFieldsForJiraServerV9(project, it.ID)
+		if err != nil {
+			return nil, err
+		}
+		fields := make(map[string]IssueTypeField, len(metaV9.Values))
+		for _, f := range metaV9.Values {
+			fields[f.FieldID] = f
+		}
+		return fields, nil
+	}
+	return nil, nil
+}
+
+// SwitchableIssueTypes filters out the types an issue of type current can't be
+// changed to with an edit. Sub-tasks need a parent and other issues can't have
+// one, so changing to or from a sub-task type is left to the "move" in Jira UI.
+func SwitchableIssueTypes(types []*IssueType, current IssueType) []*IssueType {
+	out := make([]*IssueType, 0, len(types))
+	for _, it := range types {
+		if it.Subtask != current.Subtask || strings.EqualFold(it.Name, current.Name) {
+			continue
+		}
+		out = append(out, it)
+	}
+	return out
+}
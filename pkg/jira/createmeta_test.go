@@ -154,3 +154,99 @@ func TestGetCreateMetaForJiraServerV9(t *testing.T) {
 	})
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestGetCreateMetaIssueTypes(t *testing.T) {
+	var serverV9 bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var file string
+		switch r.URL.Path {
+		case "/rest/api/2/issue/createmeta":
+			if serverV9 {
+				w.WriteHeader(404)
+				return
+			}
+			file = "./testdata/createmeta.json"
+		case "/rest/api/2/issue/createmeta/TEST/issuetypes":
+			file = "./testdata/createmetav9.json"
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+
+		resp, err := os.ReadFile(file)
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetCreateMetaIssueTypes("TEST")
+	assert.NoError(t, err)
+	assert.Equal(t, []*IssueType{{ID: "10001", Name: "Epic"}}, actual)
+
+	serverV9 = true
+
+	actual, err = client.GetCreateMetaIssueTypes("TEST")
+	assert.NoError(t, err)
+	assert.Equal(t, []*IssueType{
+		{ID: "10001", Name: "Epic"},
+		{ID: "10002", Name: "Task"},
+		{ID: "10003", Name: "Sub-task", Subtask: true},
+	}, actual)
+
+	assert.Equal(t, []*IssueType{{ID: "10001", Name: "Epic"}}, SwitchableIssueTypes(actual, IssueType{Name: "task"}))
+	assert.Empty(t, SwitchableIssueTypes(actual, IssueType{Name: "Sub-task", Subtask: true}))
+}
+
+func TestGetCreateMetaIssueTypeFields(t *testing.T) {
+	var serverV9 bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var file string
+		switch r.URL.Path {
+		case "/rest/api/2/issue/createmeta":
+			if serverV9 {
+				w.WriteHeader(404)
+				return
+			}
+			assert.Equal(t, "Epic", r.URL.Query().Get("issuetypeNames"))
+			file = "./testdata/createmeta.json"
+		case "/rest/api/2/issue/createmeta/TEST/issuetypes":
+			file = "./testdata/createmetav9.json"
+		case "/rest/api/2/issue/createmeta/TEST/issuetypes/10002":
+			file = "./testdata/createmetav9fields.json"
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+
+		resp, err := os.ReadFile(file)
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	fields, err := client.GetCreateMetaIssueTypeFields("TEST", "Epic")
+	assert.NoError(t, err)
+	assert.Equal(t, "Epic Name", fields["customfield_10011"].Name)
+
+	serverV9 = true
+
+	fields, err = client.GetCreateMetaIssueTypeFields("TEST", "task")
+	assert.NoError(t, err)
+	assert.Len(t, fields, 2)
+	assert.True(t, fields["customfield_10020"].Required)
+	assert.Equal(t, "Team", fields["customfield_10020"].Name)
+
+	fields, err = client.GetCreateMetaIssueTypeFields("TEST", "Story")
+	assert.NoError(t, err)
+	assert.Nil(t, fields)
+}
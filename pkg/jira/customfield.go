@@ -1,10 +1,22 @@
 package jira
 
+import "time"
+
+const (
+	customFieldFormatOption   = "option"
+	customFieldFormatArray    = "array"
+	customFieldFormatNumber   = "number"
+	customFieldFormatProject  = "project"
+	customFieldFormatDate     = "date"
+	customFieldFormatDateTime = "datetime"
+	customFieldFormatUser     = "user"
+)
+
 const (
-	customFieldFormatOption  = "option"
-	customFieldFormatArray   = "array"
-	customFieldFormatNumber  = "number"
-	customFieldFormatProject = "project"
+	// customFieldDateLayout is the format of date custom fields.
+	customFieldDateLayout = "2006-01-02"
+	// customFieldDateTimeLayout is the format of datetime custom fields.
+	customFieldDateTimeLayout = time.RFC3339
 )
 
 type customField map[string]interface{}
@@ -39,3 +51,8 @@ type customFieldTypeProject struct {
 type customFieldTypeProjectSet struct {
 	Set customFieldTypeProject `json:"set"`
 }
+
+type customFieldTypeUser struct {
+	AccountID string `json:"accountId,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
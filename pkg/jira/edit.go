@@ -9,6 +9,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jorres/md2adf-translator/md2adf"
 
@@ -41,7 +42,9 @@ type EditRequest struct {
 	Summary        string
 	Body           string
 	// BodyIsRawADF indicates that Body contains raw ADF JSON that should be embedded directly
-	BodyIsRawADF    bool
+	BodyIsRawADF bool
+	// ClearBody removes the description, an empty Body leaves it as is.
+	ClearBody       bool
 	Comments        []EditComment
 	Priority        string
 	Labels          []string
@@ -53,6 +56,8 @@ type EditRequest struct {
 	CustomFields map[string]string
 
 	configuredCustomFields []IssueTypeField
+	users                  map[string]*User
+	installationType       string
 }
 
 // WithCustomFields sets valid custom fields for the issue.
@@ -60,11 +65,23 @@ func (er *EditRequest) WithCustomFields(cf []IssueTypeField) {
 	er.configuredCustomFields = cf
 }
 
+// ForInstallationType sets jira installation type.
+func (er *EditRequest) ForInstallationType(it string) {
+	er.installationType = it
+}
+
+// WithUsers sets the users that values of user custom fields resolve to.
+// Values missing from the map are sent as is, as account IDs or as names on
+// local installations.
+func (er *EditRequest) WithUsers(users map[string]*User) {
+	er.users = users
+}
+
 // Edit updates an issue using PUT /issue endpoint (v3 API).
 func (c *Client) Edit(key string, req *EditRequest) error {
-	data := getRequestDataForEdit(req)
-	if data == nil {
-		return fmt.Errorf("jira: invalid request - failed to parse ADF JSON")
+	data, err := getRequestDataForEdit(req)
+	if err != nil {
+		return err
 	}
 
 	body, err := json.Marshal(&data)
@@ -89,21 +106,14 @@ func (c *Client) Edit(key string, req *EditRequest) error {
 	}
 
 	// Update comments separately
-	for _, comment := range req.Comments {
-		err := c.updateComment(key, comment)
-		if err != nil {
-			return fmt.Errorf("failed to update comment %s: %w", comment.ID, err)
-		}
-	}
-
-	return nil
+	return updateComments(key, req.Comments, c.updateComment)
 }
 
 // EditV2 updates an issue using PUT /issue endpoint (v2 API).
 func (c *Client) EditV2(key string, req *EditRequest) error {
-	data := getRequestDataForEdit(req)
-	if data == nil {
-		return fmt.Errorf("jira: invalid request - failed to parse ADF JSON")
+	data, err := getRequestDataForEdit(req)
+	if err != nil {
+		return err
 	}
 
 	body, err := json.Marshal(&data)
@@ -128,13 +138,56 @@ func (c *Client) EditV2(key string, req *EditRequest) error {
 	}
 
 	// Update comments separately
-	for _, comment := range req.Comments {
-		err := c.updateCommentV2(key, comment)
-		if err != nil {
-			return fmt.Errorf("failed to update comment %s: %w", comment.ID, err)
+	return updateComments(key, req.Comments, c.updateCommentV2)
+}
+
+// CommentUpdateFailure is a comment that couldn't be saved during an edit.
+type CommentUpdateFailure struct {
+	ID  string
+	Err error
+}
+
+// ErrCommentsUpdateFailed is returned on edit when the issue itself was saved,
+// but some of its comments were not. Updated lists the comments that were saved
+// and must not be applied again.
+type ErrCommentsUpdateFailed struct {
+	Updated []string
+	Failed  []CommentUpdateFailure
+}
+
+func (e *ErrCommentsUpdateFailed) Error() string {
+	var out strings.Builder
+
+	out.WriteString(fmt.Sprintf(
+		"issue was updated, but %d of %d comments failed to update:\n",
+		len(e.Failed), len(e.Failed)+len(e.Updated),
+	))
+	for _, f := range e.Failed {
+		out.WriteString(fmt.Sprintf("  - comment %s: %s\n", f.ID, strings.TrimSpace(f.Err.Error())))
+	}
+	if len(e.Updated) > 0 {
+		out.WriteString(fmt.Sprintf("Comments already updated: %s", strings.Join(e.Updated, ", ")))
+	} else {
+		out.WriteString("No comments were updated")
+	}
+	return out.String()
+}
+
+// updateComments updates every comment, carrying on past failures so that the
+// caller learns about all of them.
+func updateComments(issueKey string, comments []EditComment, update func(string, EditComment) error) error {
+	var res ErrCommentsUpdateFailed
+	for _, comment := range comments {
+		if err := update(issueKey, comment); err != nil {
+			res.Failed = append(res.Failed, CommentUpdateFailure{ID: comment.ID, Err: err})
+			continue
 		}
+		res.Updated = append(res.Updated, comment.ID)
 	}
 
+	if len(res.Failed) > 0 {
+		return &res
+	}
 	return nil
 }
 
@@ -276,7 +329,7 @@ func (cfm *editUpdateMarshaler) MarshalJSON() ([]byte, error) {
 	if len(cfm.M.Priority) == 0 || cfm.M.Priority[0].Set.Name == "" {
 		cfm.M.Priority = nil
 	}
-	if len(cfm.M.Components) == 0 || (cfm.M.Components[0].Add != nil && cfm.M.Components[0].Remove != nil) {
+	if len(cfm.M.Components) == 0 || (cfm.M.Components[0].Add == nil && cfm.M.Components[0].Remove == nil) {
 		cfm.M.Components = nil
 	}
 	if len(cfm.M.Labels) == 0 || (cfm.M.Labels[0].Add == "" && cfm.M.Labels[0].Remove == "") {
@@ -310,6 +363,9 @@ func (cfm *editFieldsMarshaler) MarshalJSON() ([]byte, error) {
 	}
 	dm := temp.(map[string]interface{})
 
+	if cfm.M.clearDescription {
+		dm["description"] = nil
+	}
 	for key, val := range cfm.M.customFields {
 		dm[key] = val
 	}
@@ -323,8 +379,12 @@ type Parent struct {
 }
 
 type editFields struct {
-	Parent       Parent `json:"parent,omitempty"`
-	customFields customField
+	Parent    *Parent `json:"parent,omitempty"`
+	IssueType *struct {
+		Name string `json:"name"`
+	} `json:"issuetype,omitempty"`
+	clearDescription bool
+	customFields     customField
 }
 
 type editFieldsMarshaler struct {
@@ -336,7 +396,7 @@ type editRequest struct {
 	Fields editFieldsMarshaler `json:"fields"`
 }
 
-func getRequestDataForEdit(req *EditRequest) *editRequest {
+func getRequestDataForEdit(req *EditRequest) (*editRequest, error) {
 	if req.Labels == nil {
 		req.Labels = []string{}
 	}
@@ -346,7 +406,7 @@ func getRequestDataForEdit(req *EditRequest) *editRequest {
 		// Parse the ADF JSON string into a map for direct embedding
 		var adfMap interface{}
 		if err := json.Unmarshal([]byte(req.Body), &adfMap); err != nil {
-			return nil // Return nil to indicate error, should be handled by caller
+			return nil, fmt.Errorf("jira: invalid request - failed to parse ADF JSON: %w", err)
 		}
 		descriptionContent = adfMap
 	} else {
@@ -517,30 +577,37 @@ func getRequestDataForEdit(req *EditRequest) *editRequest {
 
 	fields := editFieldsMarshaler{
 		M: editFields{
-			Parent: Parent{},
+			clearDescription: req.ClearBody && req.Body == "",
 		},
 	}
 
 	if req.ParentIssueKey != "" {
 		if req.ParentIssueKey == AssigneeNone {
-			fields.M.Parent.Set = AssigneeNone
+			fields.M.Parent = &Parent{Set: AssigneeNone}
 		} else {
-			fields.M.Parent.Key = req.ParentIssueKey
+			fields.M.Parent = &Parent{Key: req.ParentIssueKey}
 		}
 	}
+	if req.IssueType != "" {
+		fields.M.IssueType = &struct {
+			Name string `json:"name"`
+		}{Name: req.IssueType}
+	}
 
 	data := editRequest{
 		Update: update,
 		Fields: fields,
 	}
-	constructCustomFieldsForEdit(req.CustomFields, req.configuredCustomFields, &data)
+	if err := constructCustomFieldsForEdit(req.CustomFields, req.configuredCustomFields, req.users, req.installationType, &data); err != nil {
+		return nil, err
+	}
 
-	return &data
+	return &data, nil
 }
 
-func constructCustomFieldsForEdit(fields map[string]string, configuredFields []IssueTypeField, data *editRequest) {
+func constructCustomFieldsForEdit(fields map[string]string, configuredFields []IssueTypeField, users map[string]*User, installationType string, data *editRequest) error {
 	if len(fields) == 0 || len(configuredFields) == 0 {
-		return
+		return nil
 	}
 
 	data.Fields.M.customFields = make(customField)
@@ -580,6 +647,32 @@ func constructCustomFieldsForEdit(fields map[string]string, configuredFields []I
 				} else {
 					data.Fields.M.customFields[configured.Key] = []customFieldTypeNumberSet{{Set: customFieldTypeNumber(num)}}
 				}
+			case customFieldFormatDate:
+				date, err := parseCustomFieldDate(val)
+				if err != nil {
+					return fmt.Errorf("invalid value for %q: %w", configured.Name, err)
+				}
+				data.Fields.M.customFields[configured.Key] = date
+			case customFieldFormatDateTime:
+				datetime, err := parseCustomFieldDateTime(val)
+				if err != nil {
+					return fmt.Errorf("invalid value for %q: %w", configured.Name, err)
+				}
+				data.Fields.M.customFields[configured.Key] = datetime
+			case customFieldFormatUser:
+				// Jira cloud identifies users by account ID, local installations by name.
+				// Values that aren't resolved users are passed as is.
+				u, ok := users[val]
+				switch {
+				case installationType == InstallationTypeLocal && ok:
+					data.Fields.M.customFields[configured.Key] = customFieldTypeUser{Name: u.Name}
+				case installationType == InstallationTypeLocal:
+					data.Fields.M.customFields[configured.Key] = customFieldTypeUser{Name: val}
+				case ok:
+					data.Fields.M.customFields[configured.Key] = customFieldTypeUser{AccountID: u.AccountID}
+				default:
+					data.Fields.M.customFields[configured.Key] = customFieldTypeUser{AccountID: val}
+				}
 			default:
 				val, _ := md2adf.NewTranslator().TranslateToADF([]byte(val))
 				data.Fields.M.customFields[configured.Key] = val
@@ -587,6 +680,34 @@ func constructCustomFieldsForEdit(fields map[string]string, configuredFields []I
 			}
 		}
 	}
+
+	return nil
+}
+
+// parseCustomFieldDate validates a date, a full timestamp is cut down to the date.
+func parseCustomFieldDate(val string) (string, error) {
+	val = strings.TrimSpace(val)
+	for _, layout := range []string{customFieldDateLayout, time.RFC3339} {
+		if t, err := time.Parse(layout, val); err == nil {
+			return t.Format(customFieldDateLayout), nil
+		}
+	}
+	return "", fmt.Errorf("malformed date %q, expected YYYY-MM-DD", val)
+}
+
+// parseCustomFieldDateTime validates a datetime, a time without offset is in the
+// local timezone and a plain date stands for its midnight.
+func parseCustomFieldDateTime(val string) (string, error) {
+	val = strings.TrimSpace(val)
+	if t, err := time.Parse(time.RFC3339, val); err == nil {
+		return t.Format(customFieldDateTimeLayout), nil
+	}
+	for _, layout := range []string{"2006-01-02T15:04", "2006-01-02 15:04", customFieldDateLayout} {
+		if t, err := time.ParseInLocation(layout, val, time.Local); err == nil {
+			return t.Format(customFieldDateTimeLayout), nil
+		}
+	}
+	return "", fmt.Errorf("malformed datetime %q, expected RFC3339, eg: 2006-01-02T15:04:05+07:00", val)
 }
 
 func splitAddAndRemove(input []string) ([]string, []string) {
@@ -0,0 +1,198 @@
+package jira
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditReportsFailedComments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+
+		switch r.URL.Path {
+		case "/rest/api/2/issue/TEST-1":
+			w.WriteHeader(204)
+		case "/rest/api/2/issue/TEST-1/comment/10001", "/rest/api/2/issue/TEST-1/comment/10003":
+			w.WriteHeader(200)
+		default:
+			w.WriteHeader(400)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.EditV2("TEST-1", &EditRequest{
+		Summary: "Summary",
+		Comments: []EditComment{
+			{ID: "10001", Body: "first"},
+			{ID: "10002", Body: "second"},
+			{ID: "10003", Body: "third"},
+		},
+	})
+
+	var commentsErr *ErrCommentsUpdateFailed
+	assert.ErrorAs(t, err, &commentsErr)
+	assert.Equal(t, []string{"10001", "10003"}, commentsErr.Updated)
+	assert.Len(t, commentsErr.Failed, 1)
+	assert.Equal(t, "10002", commentsErr.Failed[0].ID)
+	assert.Contains(t, err.Error(), "1 of 3 comments failed")
+	assert.Contains(t, err.Error(), "Comments already updated: 10001, 10003")
+
+	err = client.EditV2("TEST-1", &EditRequest{
+		Summary:  "Summary",
+		Comments: []EditComment{{ID: "10001", Body: "first"}},
+	})
+	assert.NoError(t, err)
+}
+
+func TestGetRequestDataForEdit(t *testing.T) {
+	optionField := IssueTypeField{Name: "Tags", Key: "customfield_10010"}
+	optionField.Schema.DataType = customFieldFormatArray
+	optionField.Schema.Items = customFieldFormatOption
+
+	cases := []struct {
+		name     string
+		req      *EditRequest
+		expected string
+	}{
+		{
+			name:     "add labels",
+			req:      &EditRequest{Labels: []string{"backend", "urgent"}},
+			expected: `{"update":{"labels":[{"add":"backend"},{"add":"urgent"}]},"fields":{}}`,
+		},
+		{
+			name:     "remove labels",
+			req:      &EditRequest{Labels: []string{"-backend"}},
+			expected: `{"update":{"labels":[{"remove":"backend"}]},"fields":{}}`,
+		},
+		{
+			name: "add and remove components",
+			req:  &EditRequest{Components: []string{"-api", "web", "cli"}},
+			expected: `{"update":{"components":[
+				{"remove":{"name":"api"}},{"add":{"name":"web"}},{"add":{"name":"cli"}}
+			]},"fields":{}}`,
+		},
+		{
+			name:     "set priority",
+			req:      &EditRequest{Priority: "High"},
+			expected: `{"update":{"priority":[{"set":{"name":"High"}}]},"fields":{}}`,
+		},
+		{
+			name:     "set parent",
+			req:      &EditRequest{ParentIssueKey: "TEST-2"},
+			expected: `{"update":{},"fields":{"parent":{"key":"TEST-2"}}}`,
+		},
+		{
+			name:     "set issue type",
+			req:      &EditRequest{IssueType: "Bug"},
+			expected: `{"update":{},"fields":{"issuetype":{"name":"Bug"}}}`,
+		},
+		{
+			name:     "clear parent",
+			req:      &EditRequest{ParentIssueKey: AssigneeNone},
+			expected: `{"update":{},"fields":{"parent":{"set":"none"}}}`,
+		},
+		{
+			name:     "clear description",
+			req:      &EditRequest{ClearBody: true},
+			expected: `{"update":{},"fields":{"description":null}}`,
+		},
+		{
+			name: "custom field options",
+			req: &EditRequest{
+				CustomFields:           map[string]string{"tags": "red,-blue"},
+				configuredCustomFields: []IssueTypeField{optionField},
+			},
+			expected: `{"update":{},"fields":{"customfield_10010":[
+				{"add":{"value":"red"}},{"remove":{"value":"blue"}}
+			]}}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := getRequestDataForEdit(tc.req)
+			assert.NoError(t, err)
+
+			actual, err := json.Marshal(data)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(actual))
+		})
+	}
+}
+
+func TestEditUpdateMarshalerDropsEmptyComponents(t *testing.T) {
+	var update editUpdate
+	update.Components = make([]struct {
+		Add *struct {
+			Name string `json:"name,omitempty"`
+		} `json:"add,omitempty"`
+		Remove *struct {
+			Name string `json:"name,omitempty"`
+		} `json:"remove,omitempty"`
+	}, 1)
+
+	actual, err := json.Marshal(&editUpdateMarshaler{M: update})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(actual))
+}
+
+func TestGetRequestDataForEditDateAndUserFields(t *testing.T) {
+	field := func(name, key, dataType string) IssueTypeField {
+		f := IssueTypeField{Name: name, Key: key}
+		f.Schema.DataType = dataType
+		return f
+	}
+	configured := []IssueTypeField{
+		field("Due", "customfield_1", customFieldFormatDate),
+		field("Started", "customfield_2", customFieldFormatDateTime),
+		field("Reviewer", "customfield_3", customFieldFormatUser),
+		field("Approver", "customfield_4", customFieldFormatUser),
+	}
+
+	req := &EditRequest{CustomFields: map[string]string{
+		"due":      "2024-03-01T10:00:00Z",
+		"started":  "2024-03-01T10:00:00+02:00",
+		"reviewer": "jane@example.com",
+		"approver": "5b10ac8d82e05b22cc7d4ef5",
+	}}
+	req.WithCustomFields(configured)
+	req.WithUsers(map[string]*User{"jane@example.com": {AccountID: "5b10a2844c20165700ede21g"}})
+
+	data, err := getRequestDataForEdit(req)
+	assert.NoError(t, err)
+
+	actual, err := json.Marshal(data)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"update":{},"fields":{
+		"customfield_1":"2024-03-01",
+		"customfield_2":"2024-03-01T10:00:00+02:00",
+		"customfield_3":{"accountId":"5b10a2844c20165700ede21g"},
+		"customfield_4":{"accountId":"5b10ac8d82e05b22cc7d4ef5"}
+	}}`, string(actual))
+
+	req.ForInstallationType(InstallationTypeLocal)
+	req.WithUsers(map[string]*User{"jane@example.com": {Name: "jane"}})
+	req.CustomFields = map[string]string{"reviewer": "jane@example.com", "approver": "john"}
+	data, err = getRequestDataForEdit(req)
+	assert.NoError(t, err)
+	actual, _ = json.Marshal(data)
+	assert.JSONEq(t, `{"update":{},"fields":{
+		"customfield_3":{"name":"jane"},
+		"customfield_4":{"name":"john"}
+	}}`, string(actual))
+
+	req.CustomFields = map[string]string{"due": "01/03/2024"}
+	_, err = getRequestDataForEdit(req)
+	assert.EqualError(t, err, `invalid value for "Due": malformed date "01/03/2024", expected YYYY-MM-DD`)
+
+	req.CustomFields = map[string]string{"started": "tomorrow"}
+	_, err = getRequestDataForEdit(req)
+	assert.ErrorContains(t, err, `malformed datetime "tomorrow"`)
+}
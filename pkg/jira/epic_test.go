@@ -60,16 +60,12 @@ func TestEpicIssues(t *testing.T) {
 					Priority: struct {
 						Name string `json:"name"`
 					}{Name: "Medium"},
-					Reporter: struct {
-						Name string `json:"displayName"`
-					}{Name: "Person A"},
+					Reporter: IssueUser{Name: "Person A"},
 					Watches: struct {
 						IsWatching bool `json:"isWatching"`
 						WatchCount int  `json:"watchCount"`
 					}{IsWatching: true, WatchCount: 1},
-					Status: struct {
-						Name string `json:"name"`
-					}{Name: "To Do"},
+					Status:  IssueStatus{Name: "To Do"},
 					Created: "2020-12-03T14:05:20.974+0100",
 					Updated: "2020-12-03T14:05:20.974+0100",
 				},
@@ -83,16 +79,12 @@ func TestEpicIssues(t *testing.T) {
 					Priority: struct {
 						Name string `json:"name"`
 					}{Name: "High"},
-					Reporter: struct {
-						Name string `json:"displayName"`
-					}{Name: "Person B"},
+					Reporter: IssueUser{Name: "Person B"},
 					Watches: struct {
 						IsWatching bool `json:"isWatching"`
 						WatchCount int  `json:"watchCount"`
 					}{IsWatching: true, WatchCount: 12},
-					Status: struct {
-						Name string `json:"name"`
-					}{Name: "In Progress"},
+					Status:  IssueStatus{Name: "In Progress"},
 					Created: "2020-12-03T14:05:20.974+0100",
 					Updated: "2020-12-03T14:05:20.974+0100",
 				},
@@ -106,22 +98,16 @@ func TestEpicIssues(t *testing.T) {
 						Name string `json:"name"`
 					}{Name: "Done"},
 					IssueType: IssueType{Name: "Task"},
-					Assignee: struct {
-						Name string `json:"displayName"`
-					}{Name: "Person A"},
+					Assignee:  IssueUser{Name: "Person A"},
 					Priority: struct {
 						Name string `json:"name"`
 					}{Name: "Low"},
-					Reporter: struct {
-						Name string `json:"displayName"`
-					}{Name: "Person C"},
+					Reporter: IssueUser{Name: "Person C"},
 					Watches: struct {
 						IsWatching bool `json:"isWatching"`
 						WatchCount int  `json:"watchCount"`
 					}{IsWatching: false, WatchCount: 3},
-					Status: struct {
-						Name string `json:"name"`
-					}{Name: "Done"},
+					Status:  IssueStatus{Name: "Done"},
 					Created: "2020-12-03T14:05:20.974+0100",
 					Updated: "2020-12-03T14:05:20.974+0100",
 				},
@@ -6,7 +6,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/jorres/jira-tui/internal/debug"
 	"github.com/jorres/jira-tui/pkg/jira/filter/issue"
@@ -22,6 +27,8 @@ const (
 	IssueTypeEpic = "Epic"
 	// IssueTypeSubTask is a sub-task issue type.
 	IssueTypeSubTask = "Sub-task"
+	// StatusCategoryDone is the key of the status category of completed issues.
+	StatusCategoryDone = "done"
 	// AssigneeNone is an empty assignee.
 	AssigneeNone = "none"
 	// AssigneeDefault is a default assignee.
@@ -30,11 +37,30 @@ const (
 
 // GetIssue fetches issue details using GET /issue/{key} endpoint.
 func (c *Client) GetIssue(key string, opts ...filter.Filter) (*Issue, error) {
-	iss, err := c.getIssue(key, apiVersion3)
+	iss, err := c.getIssue(key, apiVersion3, opts...)
 	if err != nil {
 		return nil, err
 	}
 
+	convertBodiesToADF(iss, opts...)
+
+	return iss, nil
+}
+
+// UnmarshalIssue decodes a v3 GET /issue/{key} response body the same way GetIssue does,
+// i.e. description and the last comments are converted to ADF.
+func UnmarshalIssue(data []byte, opts ...filter.Filter) (*Issue, error) {
+	var iss Issue
+	if err := json.Unmarshal(data, &iss); err != nil {
+		return nil, err
+	}
+
+	convertBodiesToADF(&iss, opts...)
+
+	return &iss, nil
+}
+
+func convertBodiesToADF(iss *Issue, opts ...filter.Filter) {
 	iss.Fields.Description = ifaceToADF(iss.Fields.Description)
 
 	total := iss.Fields.Comment.Total
@@ -42,20 +68,34 @@ func (c *Client) GetIssue(key string, opts ...filter.Filter) (*Issue, error) {
 	if limit > total {
 		limit = total
 	}
-	for i := total - 1; i >= total-limit; i-- {
+	if limit > len(iss.Fields.Comment.Comments) {
+		limit = len(iss.Fields.Comment.Comments)
+	}
+	for i := len(iss.Fields.Comment.Comments) - 1; i >= len(iss.Fields.Comment.Comments)-limit; i-- {
 		body := iss.Fields.Comment.Comments[i].Body
 		iss.Fields.Comment.Comments[i].Body = ifaceToADF(body)
 	}
-	return iss, nil
 }
 
 // GetIssueV2 fetches issue details using v2 version of Jira GET /issue/{key} endpoint.
-func (c *Client) GetIssueV2(key string, _ ...filter.Filter) (*Issue, error) {
-	return c.getIssue(key, apiVersion2)
+func (c *Client) GetIssueV2(key string, opts ...filter.Filter) (*Issue, error) {
+	return c.getIssue(key, apiVersion2, opts...)
 }
 
-func (c *Client) getIssue(key, ver string) (*Issue, error) {
-	rawOut, err := c.getIssueRaw(key, ver)
+func (c *Client) getIssue(key, ver string, opts ...filter.Filter) (*Issue, error) {
+	fields, _ := filter.Collection(opts).Get(issue.KeyIssueFields).([]string)
+
+	var query string
+	if len(fields) > 0 {
+		// Custom fields are requested on top of the ones shown in the issue view.
+		// Their display names come from the names expansion.
+		query = fmt.Sprintf(
+			"?fields=%s&expand=names",
+			url.QueryEscape(strings.Join(append([]string{"*navigable", "comment"}, fields...), ",")),
+		)
+	}
+
+	rawOut, err := c.getIssueRaw(key+query, ver)
 	if err != nil {
 		return nil, err
 	}
@@ -65,9 +105,141 @@ func (c *Client) getIssue(key, ver string) (*Issue, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	if len(fields) > 0 {
+		iss.ExtraFields, err = extractFields([]byte(rawOut), fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &iss, nil
 }
 
+// extractFields collects the given fields of a raw issue response as display name to value.
+// Unknown and empty fields are skipped.
+func extractFields(data []byte, fields []string) (map[string]string, error) {
+	var raw struct {
+		Fields map[string]json.RawMessage `json:"fields"`
+		Names  map[string]string          `json:"names"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]string)
+	for _, f := range fields {
+		v, ok := raw.Fields[f]
+		if !ok {
+			continue
+		}
+		val := fieldValueString(v)
+		if val == "" {
+			continue
+		}
+		name := raw.Names[f]
+		if name == "" {
+			name = f
+		}
+		out[name] = val
+	}
+	return out, nil
+}
+
+// UnmarshalJSON decodes the fields, keeping the values of all custom fields
+// returned along with them in CustomFields by id, e.g. customfield_10020.
+func (f *IssueFields) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	v := reflect.ValueOf(f).Elem()
+	for key, value := range raw {
+		if strings.HasPrefix(key, "customfield_") {
+			if val := fieldValueString(value); val != "" {
+				if f.CustomFields == nil {
+					f.CustomFields = make(map[string]string)
+				}
+				f.CustomFields[key] = val
+			}
+			continue
+		}
+		// Keys are matched case-insensitively, as encoding/json does.
+		i, ok := issueFieldIndex()[strings.ToLower(key)]
+		if !ok {
+			continue
+		}
+		if err := json.Unmarshal(value, v.Field(i).Addr().Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// issueFieldIndex maps the lowercased JSON names of the IssueFields to their
+// struct field index.
+var issueFieldIndex = sync.OnceValue(func() map[string]int {
+	t := reflect.TypeOf(IssueFields{})
+	index := make(map[string]int, t.NumField())
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = t.Field(i).Name
+		}
+		index[strings.ToLower(name)] = i
+	}
+	return index
+})
+
+// CustomField returns the value of a custom field by its id, e.g. customfield_10020,
+// rendered as a single line. It is empty if the field isn't set.
+func (f IssueFields) CustomField(id string) string {
+	return f.CustomFields[id]
+}
+
+// fieldValueString renders a field value of any shape as a single line.
+// Objects are represented by their value or name, rich text (ADF) is skipped.
+func fieldValueString(data json.RawMessage) string {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return ""
+	}
+	return valueString(v)
+}
+
+func valueString(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(val)
+	case []interface{}:
+		items := make([]string, 0, len(val))
+		for _, item := range val {
+			if s := valueString(item); s != "" {
+				items = append(items, s)
+			}
+		}
+		return strings.Join(items, ", ")
+	case map[string]interface{}:
+		if val["type"] == "doc" {
+			return ""
+		}
+		for _, k := range []string{"value", "name", "displayName", "key"} {
+			if s, ok := val[k].(string); ok && s != "" {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
 // GetIssueRaw fetches issue details same as GetIssue but returns the raw API response body string.
 func (c *Client) GetIssueRaw(key string) (string, error) {
 	return c.getIssueRaw(key, apiVersion3)
@@ -216,6 +388,67 @@ func (c *Client) GetAssignableToIssue(issueKey string) ([]*User, error) {
 	return out, nil
 }
 
+// PreviousAssignee returns whoever was assigned to the issue before the last change
+// of the assignee, using the issue changelog. It is nil if the issue was unassigned,
+// and ErrNoResult is returned if the assignee was never changed.
+func (c *Client) PreviousAssignee(key string) (*User, error) {
+	res, err := c.GetV2(context.Background(), fmt.Sprintf("/issue/%s?fields=assignee&expand=changelog", key), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		Changelog struct {
+			Histories []struct {
+				Created string `json:"created"`
+				Items   []struct {
+					Field      string `json:"field"`
+					From       string `json:"from"`
+					FromString string `json:"fromString"`
+				} `json:"items"`
+			} `json:"histories"`
+		} `json:"changelog"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	// Cloud and server don't agree on the order of the histories, the latest wins
+	var (
+		latest time.Time
+		found  bool
+		user   *User
+	)
+	for _, h := range out.Changelog.Histories {
+		created, err := time.Parse(RFC3339, h.Created)
+		if err != nil || (found && created.Before(latest)) {
+			continue
+		}
+		for _, item := range h.Items {
+			if item.Field != "assignee" {
+				continue
+			}
+			latest, found, user = created, true, nil
+			if item.From != "" {
+				// The account id on cloud, the username on local installations
+				user = &User{AccountID: item.From, Name: item.From, DisplayName: item.FromString}
+			}
+		}
+	}
+	if !found {
+		return nil, ErrNoResult
+	}
+	return user, nil
+}
+
 // GetIssueLinkTypes fetches issue link types using GET /issueLinkType endpoint.
 func (c *Client) GetIssueLinkTypes() ([]*IssueLinkType, error) {
 	res, err := c.GetV2(context.Background(), "/issueLinkType", nil)
@@ -342,7 +575,8 @@ type issueCommentRequest struct {
 	Properties []issueCommentProperty `json:"properties"`
 }
 
-// AddIssueComment adds comment to an issue using POST /issue/{key}/comment endpoint.
+// AddIssueCommentV2 adds comment to an issue using v2 version of the POST /issue/{key}/comment endpoint.
+// Internal comments are only hidden from customers in service desk projects, elsewhere the property is ignored.
 func (c *Client) AddIssueCommentV2(key, comment string, internal bool) error {
 	body, err := json.Marshal(
 		&issueCommentRequest{
@@ -377,6 +611,7 @@ func (c *Client) AddIssueCommentV2(key, comment string, internal bool) error {
 }
 
 // AddIssueComment adds comment to an issue using POST /issue/{key}/comment endpoint.
+// Internal comments are only hidden from customers in service desk projects, elsewhere the property is ignored.
 func (c *Client) AddIssueComment(key string, comment *adf.ADFDocument, internal bool) error {
 	requestBody := map[string]any{
 		"body": comment,
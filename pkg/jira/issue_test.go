@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -63,16 +64,12 @@ func TestGetIssue(t *testing.T) {
 			Priority: struct {
 				Name string `json:"name"`
 			}{Name: "Medium"},
-			Reporter: struct {
-				Name string `json:"displayName"`
-			}{Name: "Person A"},
+			Reporter: IssueUser{Name: "Person A"},
 			Watches: struct {
 				IsWatching bool `json:"isWatching"`
 				WatchCount int  `json:"watchCount"`
 			}{IsWatching: true, WatchCount: 1},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "To Do"},
+			Status:  IssueStatus{Name: "To Do"},
 			Created: "2020-12-03T14:05:20.974+0100",
 			Updated: "2020-12-03T14:05:20.974+0100",
 			IssueLinks: []struct {
@@ -133,16 +130,12 @@ func TestGetIssueWithoutDescription(t *testing.T) {
 			Priority: struct {
 				Name string `json:"name"`
 			}{Name: "Medium"},
-			Reporter: struct {
-				Name string `json:"displayName"`
-			}{Name: "Person A"},
+			Reporter: IssueUser{Name: "Person A"},
 			Watches: struct {
 				IsWatching bool `json:"isWatching"`
 				WatchCount int  `json:"watchCount"`
 			}{IsWatching: true, WatchCount: 1},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "To Do"},
+			Status:  IssueStatus{Name: "To Do"},
 			Created: "2020-12-03T14:05:20.974+0100",
 			Updated: "2020-12-03T14:05:20.974+0100",
 		},
@@ -184,16 +177,12 @@ func TestGetIssueV2(t *testing.T) {
 			Priority: struct {
 				Name string `json:"name"`
 			}{Name: "Medium"},
-			Reporter: struct {
-				Name string `json:"displayName"`
-			}{Name: "Person A"},
+			Reporter: IssueUser{Name: "Person A"},
 			Watches: struct {
 				IsWatching bool `json:"isWatching"`
 				WatchCount int  `json:"watchCount"`
 			}{IsWatching: true, WatchCount: 1},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "To Do"},
+			Status:  IssueStatus{Name: "To Do"},
 			Created: "2020-12-03T14:05:20.974+0100",
 			Updated: "2020-12-03T14:05:20.974+0100",
 		},
@@ -387,6 +376,48 @@ func TestAssignIssue(t *testing.T) {
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
 
+func TestPreviousAssignee(t *testing.T) {
+	var changelog string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+		assert.Equal(t, "changelog", r.URL.Query().Get("expand"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(changelog))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	changelog = `{"changelog": {"histories": [
+		{"created": "2024-03-02T10:00:00.000+0000", "items": [
+			{"field": "assignee", "from": "b2", "fromString": "Bob", "to": "c3", "toString": "Carol"}
+		]},
+		{"created": "2024-03-01T10:00:00.000+0000", "items": [
+			{"field": "status", "fromString": "To Do", "toString": "In Progress"},
+			{"field": "assignee", "from": "a1", "fromString": "Alice", "to": "b2", "toString": "Bob"}
+		]}
+	]}}`
+	user, err := client.PreviousAssignee("TEST-1")
+	assert.NoError(t, err)
+	assert.Equal(t, &User{AccountID: "b2", Name: "b2", DisplayName: "Bob"}, user)
+
+	changelog = `{"changelog": {"histories": [
+		{"created": "2024-03-01T10:00:00.000+0000", "items": [
+			{"field": "assignee", "from": null, "to": "a1", "toString": "Alice"}
+		]}
+	]}}`
+	user, err = client.PreviousAssignee("TEST-1")
+	assert.NoError(t, err)
+	assert.Nil(t, user)
+
+	changelog = `{"changelog": {"histories": []}}`
+	_, err = client.PreviousAssignee("TEST-1")
+	assert.ErrorIs(t, err, ErrNoResult)
+}
+
 func TestGetIssueLinkTypes(t *testing.T) {
 	var unexpectedStatusCode bool
 
@@ -553,6 +584,99 @@ func TestAddIssueComment(t *testing.T) {
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
 
+func TestAddIssueCommentInternal(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		switch r.URL.Path {
+		case "/rest/api/2/issue/TEST-1/comment":
+			assert.Equal(t, `{"body":"note","properties":[{"key":"sd.public.comment","value":{"internal":true}}]}`, actualBody.String())
+		case "/rest/api/3/issue/TEST-1/comment":
+			assert.Contains(t, actualBody.String(), `"properties":[{"key":"sd.public.comment","value":{"internal":true}}]`)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.WriteHeader(201)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	assert.NoError(t, client.AddIssueCommentV2("TEST-1", "note", true))
+	assert.NoError(t, client.AddIssueComment("TEST-1", adf.NewADFDocument(), true))
+}
+
+func TestCommentIsInternal(t *testing.T) {
+	var comments Comments
+
+	err := json.Unmarshal([]byte(`[
+		{"id": "1", "body": "public", "jsdPublic": true},
+		{"id": "2", "body": "internal", "jsdPublic": false},
+		{"id": "3", "body": "internal property", "properties": [{"key": "sd.public.comment", "value": {"internal": true}}]},
+		{"id": "4", "body": "regular"}
+	]`), &comments)
+	assert.NoError(t, err)
+
+	assert.False(t, comments[0].IsInternal())
+	assert.True(t, comments[1].IsInternal())
+	assert.True(t, comments[2].IsInternal())
+	assert.False(t, comments[3].IsInternal())
+}
+
+func TestExtractFields(t *testing.T) {
+	data := []byte(`{
+		"fields": {
+			"customfield_1": {"value": "Red"},
+			"customfield_2": 8.5,
+			"customfield_3": [{"name": "Backend"}, {"name": "API"}],
+			"customfield_4": null,
+			"customfield_5": {"type": "doc", "content": []},
+			"customfield_6": "plain"
+		},
+		"names": {
+			"customfield_1": "Color",
+			"customfield_2": "Story Points",
+			"customfield_3": "Teams"
+		}
+	}`)
+
+	fields, err := extractFields(data, []string{
+		"customfield_1", "customfield_2", "customfield_3", "customfield_4",
+		"customfield_5", "customfield_6", "customfield_7",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"Color":         "Red",
+		"Story Points":  "8.5",
+		"Teams":         "Backend, API",
+		"customfield_6": "plain",
+	}, fields)
+}
+
+func TestIssueFieldsCustomField(t *testing.T) {
+	var iss Issue
+	err := json.Unmarshal([]byte(`{
+		"key": "TEST-1",
+		"fields": {
+			"summary": "Custom fields",
+			"customfield_10020": {"value": "Platform"},
+			"customfield_10021": [{"name": "Backend"}, {"name": "API"}],
+			"customfield_10022": null
+		}
+	}`), &iss)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Custom fields", iss.Fields.Summary)
+	assert.Equal(t, "Platform", iss.Fields.CustomField("customfield_10020"))
+	assert.Equal(t, "Backend, API", iss.Fields.CustomField("customfield_10021"))
+	assert.Empty(t, iss.Fields.CustomField("customfield_10022"))
+	assert.Equal(t, map[string]string{
+		"customfield_10020": "Platform",
+		"customfield_10021": "Backend, API",
+	}, iss.Fields.CustomFields)
+}
+
 func TestAddIssueWorklog(t *testing.T) {
 	var unexpectedStatusCode bool
 
@@ -35,8 +35,9 @@ func TestMe(t *testing.T) {
 	assert.NoError(t, err)
 
 	expected := &Me{
-		Name:  "Person A",
-		Email: "user@test.com",
+		AccountID: "a12b3",
+		Name:      "Person A",
+		Email:     "user@test.com",
 	}
 	assert.Equal(t, expected, actual)
 
@@ -45,3 +46,23 @@ func TestMe(t *testing.T) {
 	_, err = client.Me()
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestMeUnauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(401)
+	}))
+	defer server.Close()
+
+	bearer := AuthTypeBearer
+	client := NewClient(Config{Server: server.URL, APIToken: "expired", AuthType: &bearer}, WithTimeout(3*time.Second))
+
+	_, err := client.Me()
+	var resp *ErrUnexpectedResponse
+	assert.ErrorAs(t, err, &resp)
+	assert.Equal(t, AuthTypeBearer, resp.AuthType)
+	assert.Contains(t, err.Error(), "Bearer token rejected (401 Unauthorized), the personal access token may be expired")
+
+	client = NewClient(Config{Server: server.URL, Login: "person@example.com", APIToken: "wrong"}, WithTimeout(3*time.Second))
+	_, err = client.Me()
+	assert.Contains(t, err.Error(), "Basic auth failed (401 Unauthorized), check the login and the API token.")
+}
@@ -0,0 +1,31 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetPriorities fetches all issue priorities using GET /priority endpoint.
+func (c *Client) GetPriorities() ([]*Priority, error) {
+	res, err := c.GetV2(context.Background(), "/priority", nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*Priority
+
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,39 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SavedFilter holds info of a filter saved in Jira.
+type SavedFilter struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	JQL  string `json:"jql"`
+}
+
+// GetFilter fetches a saved filter using GET /filter/{id} endpoint.
+func (c *Client) GetFilter(id int) (*SavedFilter, error) {
+	res, err := c.GetV2(context.Background(), fmt.Sprintf("/filter/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out SavedFilter
+
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
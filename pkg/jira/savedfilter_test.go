@@ -0,0 +1,48 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFilter(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/filter/12345", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+		} else {
+			resp, err := os.ReadFile("./testdata/filter.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetFilter(12345)
+	assert.NoError(t, err)
+
+	expected := &SavedFilter{
+		ID:   "12345",
+		Name: "Team bugs",
+		JQL:  "type = Bug AND statusCategory != Done",
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetFilter(12345)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -55,7 +55,8 @@ func (c *Client) TransitionsV2(key string) ([]*Transition, error) {
 }
 
 func (c *Client) transitions(key, ver string) ([]*Transition, error) {
-	path := fmt.Sprintf("/issue/%s/transitions", key)
+	// Fields of the transition screens tell if e.g. a resolution has to be set.
+	path := fmt.Sprintf("/issue/%s/transitions?expand=transitions.fields", key)
 
 	var (
 		res *http.Response
@@ -24,6 +24,7 @@ func TestTransitions(t *testing.T) {
 		} else {
 			assert.Equal(t, "/rest/api/3/issue/TEST/transitions", r.URL.Path)
 		}
+		assert.Equal(t, "transitions.fields", r.URL.Query().Get("expand"))
 
 		assert.Equal(t, "GET", r.Method)
 
@@ -60,9 +61,14 @@ func TestTransitions(t *testing.T) {
 			ID:          "31",
 			Name:        "Done",
 			IsAvailable: false,
+			Fields: map[string]TransitionField{
+				"resolution": {Name: "Resolution", Required: true},
+			},
 		},
 	}
 	assert.Equal(t, expected, actual)
+	assert.False(t, actual[1].RequiresResolution())
+	assert.True(t, actual[2].RequiresResolution())
 
 	apiVersion2 = true
 	unexpectedStatusCode = true
@@ -37,6 +37,11 @@ type Project struct {
 	Type string `json:"style"`
 }
 
+// This allows for `Project` type to be passed to FuzzySelector
+func (p Project) FilterValue() string { return fmt.Sprintf("%s %s", p.Key, p.Name) }
+func (p Project) Description() string { return p.Key }
+func (p Project) Title() string       { return p.Name }
+
 // Board holds board info.
 type Board struct {
 	ID   int    `json:"id"`
@@ -54,6 +59,10 @@ type Epic struct {
 type Issue struct {
 	Key    string      `json:"key"`
 	Fields IssueFields `json:"fields"`
+
+	// ExtraFields holds the fields requested with the fields filter by their
+	// display name, see issue.NewFieldsFilter.
+	ExtraFields map[string]string `json:"-"`
 }
 
 // This allows for `Issue` type to be passed to FuzzySelector
@@ -61,11 +70,53 @@ func (i Issue) FilterValue() string { return fmt.Sprintf("%s %s", i.Key, i.Field
 func (i Issue) Description() string { return i.Fields.Summary }
 func (i Issue) Title() string       { return i.Key }
 
-type Comments []struct {
+type Comments []Comment
+
+// Comment holds a single comment of an issue.
+type Comment struct {
 	ID      string      `json:"id"`
 	Author  User        `json:"author"`
 	Body    interface{} `json:"body"` // string in v1/v2, adf.ADF in v3
 	Created string      `json:"created"`
+
+	// JSDPublic is only set on Jira Service Management issues,
+	// false means the comment is not visible to the customer.
+	JSDPublic  *bool `json:"jsdPublic,omitempty"`
+	Properties []struct {
+		Key   string `json:"key"`
+		Value struct {
+			Internal bool `json:"internal"`
+		} `json:"value"`
+	} `json:"properties,omitempty"`
+}
+
+// IsInternal tells if the comment is an internal note, hidden from service desk customers.
+func (c Comment) IsInternal() bool {
+	if c.JSDPublic != nil {
+		return !*c.JSDPublic
+	}
+	for _, p := range c.Properties {
+		if p.Key == "sd.public.comment" {
+			return p.Value.Internal
+		}
+	}
+	return false
+}
+
+// IssueStatus holds the status of an issue.
+type IssueStatus struct {
+	Name           string `json:"name"`
+	StatusCategory struct {
+		Key string `json:"key"` // one of new, indeterminate, done
+	} `json:"statusCategory"`
+}
+
+// IssueUser holds the assignee or the reporter of an issue.
+type IssueUser struct {
+	Name      string `json:"displayName"`
+	Email     string `json:"emailAddress"`
+	AccountID string `json:"accountId,omitempty"`
+	Login     string `json:"name,omitempty"`
 }
 
 // IssueFields holds issue fields.
@@ -78,24 +129,21 @@ type IssueFields struct {
 	} `json:"resolution"`
 	IssueType IssueType `json:"issueType"`
 	Parent    *struct {
-		Key string `json:"key"`
+		Key    string `json:"key"`
+		Fields struct {
+			Summary string `json:"summary"`
+		} `json:"fields"`
 	} `json:"parent,omitempty"`
-	Assignee struct {
-		Name string `json:"displayName"`
-	} `json:"assignee"`
+	Assignee IssueUser `json:"assignee"`
 	Priority struct {
 		Name string `json:"name"`
 	} `json:"priority"`
-	Reporter struct {
-		Name string `json:"displayName"`
-	} `json:"reporter"`
-	Watches struct {
+	Reporter IssueUser `json:"reporter"`
+	Watches  struct {
 		IsWatching bool `json:"isWatching"`
 		WatchCount int  `json:"watchCount"`
 	} `json:"watches"`
-	Status struct {
-		Name string `json:"name"`
-	} `json:"status"`
+	Status     IssueStatus `json:"status"`
 	Components []struct {
 		Name string `json:"name"`
 	} `json:"components"`
@@ -120,11 +168,23 @@ type IssueFields struct {
 		InwardIssue  *Issue `json:"inwardIssue,omitempty"`
 		OutwardIssue *Issue `json:"outwardIssue,omitempty"`
 	} `json:"issueLinks"`
+	Attachments  []Attachment      `json:"attachment"`
 	Created      string            `json:"created"`
 	Updated      string            `json:"updated"`
 	CustomFields map[string]string `json:"-"`
 }
 
+// Attachment holds issue attachment info.
+type Attachment struct {
+	ID       string `json:"id"`
+	Filename string `json:"filename"`
+	MimeType string `json:"mimeType"`
+	Size     int    `json:"size"`
+	Content  string `json:"content"`
+	Author   User   `json:"author"`
+	Created  string `json:"created"`
+}
+
 // Field holds field info.
 type Field struct {
 	ID     string `json:"id"`
@@ -146,6 +206,9 @@ type IssueTypeField struct {
 		Items    string `json:"items,omitempty"`
 	} `json:"schema"`
 	FieldID string `json:"fieldId,omitempty"`
+	// Required and HasDefaultValue are only set in the create metadata.
+	Required        bool `json:"required,omitempty"`
+	HasDefaultValue bool `json:"hasDefaultValue,omitempty"`
 }
 
 // IssueType holds issue type info.
@@ -156,6 +219,16 @@ type IssueType struct {
 	Subtask bool   `json:"subtask"`
 }
 
+// This allows for `IssueType` type to be passed to FuzzySelector
+func (it IssueType) FilterValue() string { return it.Name }
+func (it IssueType) Title() string       { return it.Name }
+func (it IssueType) Description() string {
+	if it.Subtask {
+		return "Sub-task"
+	}
+	return ""
+}
+
 // IssueLinkType holds issue link type info.
 type IssueLinkType struct {
 	ID      string `json:"id"`
@@ -164,6 +237,12 @@ type IssueLinkType struct {
 	Outward string `json:"outward"`
 }
 
+// Priority holds issue priority info.
+type Priority struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
 // Sprint holds sprint info.
 type Sprint struct {
 	ID           int    `json:"id"`
@@ -180,6 +259,37 @@ type Transition struct {
 	ID          json.Number `json:"id"`
 	Name        string      `json:"name"`
 	IsAvailable bool        `json:"isAvailable"`
+	// Fields are the fields on the transition screen, keyed by field id.
+	Fields map[string]TransitionField `json:"fields,omitempty"`
+}
+
+// TransitionField holds info of a field on the transition screen.
+type TransitionField struct {
+	Name     string `json:"name"`
+	Required bool   `json:"required"`
+}
+
+// RequiresResolution tells if the transition screen asks for the resolution.
+func (t Transition) RequiresResolution() bool {
+	_, ok := t.Fields["resolution"]
+	return ok
+}
+
+// This allows for `Transition` type to be passed to FuzzySelector
+func (t Transition) FilterValue() string { return t.Name }
+func (t Transition) Title() string       { return t.Name }
+func (t Transition) Description() string {
+	if t.RequiresResolution() {
+		return "Asks for a resolution"
+	}
+	return ""
+}
+
+// Resolution holds issue resolution info.
+type Resolution struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
 }
 
 // This allows for `User` type to be passed to FuzzySelector